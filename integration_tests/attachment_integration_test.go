@@ -46,11 +46,40 @@ func TestAttachmentIntegration(t *testing.T) {
 
 	Convey("should be able to list case attachments", t, func() {
 		cse, _ := createCaseWithAttachment()
-		collection, _, err := client.Case.Attachment.List(cse.GetResourceId())
+		collection, _, err := client.Case.Attachment.List(cse.GetResourceId(), nil)
 		So(err, ShouldBeNil)
 		So(collection, ShouldNotBeNil)
 		So(*collection.TotalEntries, ShouldBeGreaterThan, 0)
 		So(*collection.Embedded, ShouldNotBeNil)
 	})
 
+	Convey("should be able to create and list message attachments", t, func() {
+		cse := BuildSampleCase()
+		createdCase, _, err := client.Case.Create(cse)
+		So(err, ShouldBeNil)
+		createdAttachment, _, err := client.Case.Attachment.CreateForMessage(createdCase.GetResourceId(), BuildSampleAttachment())
+		So(err, ShouldBeNil)
+		collection, _, err := client.Case.Attachment.ListForMessage(createdCase.GetResourceId(), nil)
+		So(err, ShouldBeNil)
+		So(*collection.TotalEntries, ShouldBeGreaterThan, 0)
+		_, err = client.Case.Attachment.DeleteForMessage(createdCase.GetResourceId(), createdAttachment.GetResourceId())
+		So(err, ShouldBeNil)
+	})
+
+	Convey("should be able to create and list reply attachments", t, func() {
+		cse := BuildSampleCase()
+		createdCase, _, err := client.Case.Create(cse)
+		So(err, ShouldBeNil)
+		reply, _, err := client.Case.Reply.Create(createdCase.GetResourceId(), BuildSampleReply())
+		So(err, ShouldBeNil)
+		createdAttachment, _, err := client.Case.Attachment.CreateForReply(createdCase.GetResourceId(), reply.GetResourceId(), BuildSampleAttachment())
+		So(err, ShouldBeNil)
+		showAttach, _, err := client.Case.Attachment.GetForReply(createdCase.GetResourceId(), reply.GetResourceId(), createdAttachment.GetResourceId())
+		So(err, ShouldBeNil)
+		So(showAttach.GetResourceId(), ShouldEqual, createdAttachment.GetResourceId())
+		collection, _, err := client.Case.Attachment.ListForReply(createdCase.GetResourceId(), reply.GetResourceId(), nil)
+		So(err, ShouldBeNil)
+		So(*collection.TotalEntries, ShouldBeGreaterThan, 0)
+	})
+
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
 	resource "github.com/wtlangford/go-desk/resource"
+	service "github.com/wtlangford/go-desk/service"
 	types "github.com/wtlangford/go-desk/types"
 	"log"
 	"net/url"
@@ -22,17 +23,46 @@ func TestCaseIntegration(t *testing.T) {
 		log.Println("case %v", cse)
 		So(*cse.Subject, ShouldNotBeBlank)
 	})
+	Convey("should be able to retrieve selected fields of a case", t, func() {
+		cse, _, err := client.Case.Get("1", service.CaseFieldStatus, service.CaseFieldUpdatedAt)
+		So(err, ShouldBeNil)
+		So(cse.Status, ShouldNotBeNil)
+		So(cse.Blurb, ShouldBeNil)
+	})
+	Convey("should be able to follow the customer link of a case", t, func() {
+		cse, _, err := client.Case.Get("1")
+		So(err, ShouldBeNil)
+		linked, _, err := client.Follow(cse, "customer")
+		So(err, ShouldBeNil)
+		So(linked, ShouldHaveSameTypeAs, &resource.Customer{})
+	})
 	Convey("should be able to list cases", t, func() {
-		listParams := url.Values{}
-		listParams.Add("sort_field", "created_at")
-		listParams.Add("sort_direction", "asc")
-		collection, _, err := client.Case.List(&listParams)
+		opts := &service.ListOptions{SortField: "created_at", SortDirection: service.SortAsc}
+		collection, _, err := client.Case.List(opts)
 		So(err, ShouldBeNil)
 		log.Println("collection %v", collection)
 		So(collection, ShouldHaveSameTypeAs, &resource.Page{})
 		So(*collection.TotalEntries, ShouldBeGreaterThan, 0)
 		So(*collection.Embedded, ShouldNotBeNil)
 	})
+	Convey("should be able to navigate to the next page of cases", t, func() {
+		collection, _, err := client.Case.List(&service.ListOptions{PerPage: 1})
+		So(err, ShouldBeNil)
+		So(collection.HasNext(), ShouldBeTrue)
+		next, _, err := collection.Next()
+		So(err, ShouldBeNil)
+		So(*next.PageNumber, ShouldEqual, 2)
+		So(next.HasPrev(), ShouldBeTrue)
+	})
+	Convey("should be able to stream cases with prefetching", t, func() {
+		stream := client.Case.ListStream(&service.ListOptions{PerPage: 2}, &service.PrefetchOptions{Window: 2})
+		defer stream.Close()
+		for i := 0; i < 5; i++ {
+			entry, err := stream.Next()
+			So(err, ShouldBeNil)
+			So(entry, ShouldHaveSameTypeAs, resource.Case{})
+		}
+	})
 	Convey("should be able to search for cases", t, func() {
 		searchParams := url.Values{}
 		searchParams.Add("sort_field", "created_at")
@@ -44,6 +74,12 @@ func TestCaseIntegration(t *testing.T) {
 		So(*collection.TotalEntries, ShouldBeGreaterThan, 0)
 		So(*collection.Embedded, ShouldNotBeNil)
 	})
+	Convey("should be able to search for cases with a typed query", t, func() {
+		query := service.NewCaseQuery().Status(resource.CaseStatusNew, resource.CaseStatusOpen)
+		collection, _, err := client.Case.SearchWith(query)
+		So(err, ShouldBeNil)
+		So(*collection.TotalEntries, ShouldBeGreaterThan, 0)
+	})
 	Convey("should be able to update a case", t, func() {
 		subject := types.String(fmt.Sprintf("updated case at %v", time.Now()))
 		cse := resource.NewCase()
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
 	resource "github.com/wtlangford/go-desk/resource"
+	service "github.com/wtlangford/go-desk/service"
 	types "github.com/wtlangford/go-desk/types"
 	"log"
 	"net/url"
@@ -24,10 +25,8 @@ func TestCompanyIntegration(t *testing.T) {
 	})
 
 	Convey("should be able to retrieve a list of companies", t, func() {
-		listParams := url.Values{}
-		listParams.Add("sort_field", "created_at")
-		listParams.Add("sort_direction", "asc")
-		collection, _, err := client.Company.List(&listParams)
+		opts := &service.ListOptions{SortField: "created_at", SortDirection: service.SortAsc}
+		collection, _, err := client.Company.List(opts)
 		So(err, ShouldBeNil)
 		So(*collection.TotalEntries, ShouldBeGreaterThan, 0)
 		So(*collection.Embedded, ShouldNotBeNil)
@@ -66,20 +65,16 @@ func TestCompanyIntegration(t *testing.T) {
 	})
 
 	Convey("should be able to get company cases", t, func() {
-		params := url.Values{}
-		params.Add("sort_field", "created_at")
-		params.Add("sort_direction", "asc")
-		collection, _, err := client.Company.Cases(fmt.Sprintf("%d", DefaultCompanyId), &params)
+		opts := &service.ListOptions{SortField: "created_at", SortDirection: service.SortAsc}
+		collection, _, err := client.Company.Cases(fmt.Sprintf("%d", DefaultCompanyId), opts)
 		So(err, ShouldBeNil)
 		So(*collection.TotalEntries, ShouldBeGreaterThan, 0)
 		So(*collection.Embedded, ShouldNotBeNil)
 	})
 
 	Convey("should be able to get company customers", t, func() {
-		params := url.Values{}
-		params.Add("sort_field", "created_at")
-		params.Add("sort_direction", "asc")
-		collection, _, err := client.Company.Customers(fmt.Sprintf("%d", DefaultCompanyId), &params)
+		opts := &service.ListOptions{SortField: "created_at", SortDirection: service.SortAsc}
+		collection, _, err := client.Company.Customers(fmt.Sprintf("%d", DefaultCompanyId), opts)
 		So(err, ShouldBeNil)
 		So(*collection.TotalEntries, ShouldBeGreaterThan, 0)
 		So(*collection.Embedded, ShouldNotBeNil)
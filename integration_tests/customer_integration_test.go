@@ -5,6 +5,7 @@ import (
 	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
 	resource "github.com/wtlangford/go-desk/resource"
+	service "github.com/wtlangford/go-desk/service"
 	types "github.com/wtlangford/go-desk/types"
 	"net/url"
 	"testing"
@@ -24,10 +25,8 @@ func TestCustomerIntegration(t *testing.T) {
 	})
 
 	Convey("should be able to retrieve a list of customers", t, func() {
-		listParams := url.Values{}
-		listParams.Add("sort_field", "created_at")
-		listParams.Add("sort_direction", "asc")
-		collection, _, err := client.Customer.List(&listParams)
+		opts := &service.ListOptions{SortField: "created_at", SortDirection: service.SortAsc}
+		collection, _, err := client.Customer.List(opts)
 		So(err, ShouldBeNil)
 		So(*collection.TotalEntries, ShouldBeGreaterThan, 0)
 		So(*collection.Embedded, ShouldNotBeNil)
@@ -65,13 +64,21 @@ func TestCustomerIntegration(t *testing.T) {
 	})
 
 	Convey("should be able to retrieve cases for a customer", t, func() {
-		params := url.Values{}
-		params.Add("sort_field", "created_at")
-		params.Add("sort_direction", "asc")
-		collection, _, err := client.Customer.Cases(fmt.Sprintf("%d", DefaultCustomerId), &params)
+		opts := &service.ListOptions{SortField: "created_at", SortDirection: service.SortAsc}
+		collection, _, err := client.Customer.Cases(fmt.Sprintf("%d", DefaultCustomerId), opts)
 		So(err, ShouldBeNil)
 		So(*collection.TotalEntries, ShouldBeGreaterThan, 0)
 		So(*collection.Embedded, ShouldNotBeNil)
 	})
 
+	Convey("should be able to create a case for a customer", t, func() {
+		cse := resource.NewCase()
+		cse.Type = resource.CaseTypeEmail.Ptr()
+		cse.Subject = types.String("Case created by API via resource-go")
+		cse.Message = BuildSampleMessage()
+		newCase, _, err := client.Customer.CreateCase(fmt.Sprintf("%d", DefaultCustomerId), cse)
+		So(err, ShouldBeNil)
+		So(newCase.GetResourceId(), ShouldNotBeBlank)
+	})
+
 }
@@ -3,6 +3,7 @@ package integration_tests
 import (
 	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
+	"github.com/wtlangford/go-desk/resource"
 	"log"
 	"testing"
 	"time"
@@ -54,5 +55,17 @@ func TestDraftIntegration(t *testing.T) {
 		So(err, ShouldBeNil)
 		So(*updatedDraft.Body, ShouldEqual, *newDraft.Body)
 	})
+	Convey("should be able to send a case draft", t, func() {
+		cse := BuildSampleCase()
+		createdCase, _, err := client.Case.Create(cse)
+		So(err, ShouldBeNil)
+		workflow, _, err := client.Case.Draft.Start(createdCase.GetResourceId(), BuildSampleDraft())
+		So(err, ShouldBeNil)
+		_, _, err = workflow.SetBody(fmt.Sprintf("body sent at %v", time.Now()))
+		So(err, ShouldBeNil)
+		reply, _, err := workflow.Send()
+		So(err, ShouldBeNil)
+		So(*reply.Status, ShouldNotEqual, resource.ReplyStatusDraft)
+	})
 
 }
@@ -3,7 +3,7 @@ package integration_tests
 import (
 	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
-	"net/url"
+	service "github.com/wtlangford/go-desk/service"
 	"testing"
 )
 
@@ -20,17 +20,15 @@ func TestGroupIntegration(t *testing.T) {
 	})
 
 	Convey("should be able to retrieve a list of groups", t, func() {
-		listParams := url.Values{}
-		listParams.Add("sort_field", "created_at")
-		listParams.Add("sort_direction", "asc")
-		collection, _, err := client.Group.List(&listParams)
+		opts := &service.ListOptions{SortField: "created_at", SortDirection: service.SortAsc}
+		collection, _, err := client.Group.List(opts)
 		So(err, ShouldBeNil)
 		So(*collection.TotalEntries, ShouldBeGreaterThan, 0)
 		So(*collection.Embedded, ShouldNotBeNil)
 	})
 
 	Convey("should be able to retrieve a list of users for a group", t, func() {
-		collection, _, err := client.Group.Users(fmt.Sprintf("%d", DefaultGroupId))
+		collection, _, err := client.Group.Users(fmt.Sprintf("%d", DefaultGroupId), nil)
 		So(err, ShouldBeNil)
 		So(*collection.TotalEntries, ShouldBeGreaterThan, 0)
 		So(*collection.Embedded, ShouldNotBeNil)
@@ -0,0 +1,32 @@
+package integration_tests
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	resource "github.com/wtlangford/go-desk/resource"
+	"testing"
+	"time"
+)
+
+func TestInsightsIntegration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("integration tests are skipped in short mode.")
+	}
+	client := CreateClient()
+
+	Convey("should be able to retrieve insights meta data", t, func() {
+		meta, _, err := client.Insights.Meta()
+		So(err, ShouldBeNil)
+		So(meta.Metrics, ShouldNotBeEmpty)
+	})
+
+	Convey("should be able to run a report", t, func() {
+		now := time.Now()
+		query := resource.NewReportQuery().
+			SetResolution(resource.ReportResolutionDays).
+			SetDateRange(now.AddDate(0, 0, -7), now).
+			AddMetric(resource.ReportMetricCasesReceived, resource.ReportMetricCasesResolved)
+		report, _, err := client.Insights.Report(query)
+		So(err, ShouldBeNil)
+		So(report, ShouldNotBeNil)
+	})
+}
@@ -50,8 +50,8 @@ func SetupEnv() {
 
 func BuildSampleMessage() *resource.Message {
 	message := resource.MessageBuilder.
-		SetString("Direction", "in").
-		SetString("Status", "received").
+		SetValue("Direction", resource.DirectionIn).
+		SetValue("Status", resource.ReplyStatusReceived).
 		SetString("To", "someone@resource.com").
 		SetString("From", "someone-else@resource.com").
 		SetString("Subject", "Case created by API via resource-go").
@@ -63,24 +63,24 @@ func BuildSampleMessage() *resource.Message {
 func BuildSampleDraft() *resource.Draft {
 	draft := resource.NewDraft()
 	draft.Body = types.String("nice body")
-	draft.Direction = types.String("out")
-	draft.Status = types.String("draft")
+	draft.Direction = resource.DirectionOut.Ptr()
+	draft.Status = resource.ReplyStatusDraft.Ptr()
 	return draft
 }
 
 func BuildSampleReply() *resource.Reply {
 	reply := resource.ReplyBuilder.
 		SetString("Body", "some body").
-		SetString("Direction", "out").
-		SetString("Status", "draft").
+		SetValue("Direction", resource.DirectionOut).
+		SetValue("Status", resource.ReplyStatusDraft).
 		BuildReply()
 	return &reply
 }
 
 func BuildSampleCase() *resource.Case {
 	message := resource.MessageBuilder.
-		SetString("Direction", "in").
-		SetString("Status", "received").
+		SetValue("Direction", resource.DirectionIn).
+		SetValue("Status", resource.ReplyStatusReceived).
 		SetString("To", "someone@resource.com").
 		SetString("From", "someone-else@resource.com").
 		SetString("Subject", "Case created by API via resource-go").
@@ -91,10 +91,10 @@ func BuildSampleCase() *resource.Case {
 	// 	customerId = DefaultCustomerId
 	// }
 	caze := resource.CaseBuilder.
-		SetString("Type", "email").
+		SetValue("Type", resource.CaseTypeEmail).
 		SetString("Subject", "Case created by API via resource-go").
 		SetInt("Priority", 4).
-		SetString("Status", "received").
+		SetValue("Status", resource.CaseStatusNew).
 		SetMessage(message).
 		AddHrefLink("customer", fmt.Sprintf("/api/v2/customers/%d", DefaultCustomerId)).
 		BuildCase()
@@ -35,7 +35,7 @@ func TestMessageIntegration(t *testing.T) {
 			SetString("Type", "email").
 			SetString("Subject", "Case created by API via desk-go").
 			SetInt("Priority", 4).
-			SetString("Status", "received").
+			SetString("Status", "new").
 			SetMessage(message).
 			AddHrefLink("customer", fmt.Sprintf("/api/v2/customers/%d", DefaultCustomerId)).
 			BuildCase()
@@ -63,7 +63,7 @@ func TestMessageIntegration(t *testing.T) {
 			SetString("Type", "email").
 			SetString("Subject", "Case created by API via desk-go").
 			SetInt("Priority", 4).
-			SetString("Status", "received").
+			SetString("Status", "new").
 			SetMessage(message).
 			AddHrefLink("customer", fmt.Sprintf("/api/v2/customers/%d", DefaultCustomerId)).
 			BuildCase()
@@ -4,9 +4,9 @@ import (
 	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
 	resource "github.com/wtlangford/go-desk/resource"
+	service "github.com/wtlangford/go-desk/service"
 	types "github.com/wtlangford/go-desk/types"
 	"log"
-	"net/url"
 	"testing"
 	"time"
 )
@@ -60,10 +60,8 @@ func TestNoteIntegration(t *testing.T) {
 
 	Convey("should be able to list case notes", t, func() {
 		cse, _ := createCaseWithNote()
-		params := url.Values{}
-		params.Add("sort_field", "created_at")
-		params.Add("sort_direction", "asc")
-		collection, _, err := client.Case.Note.List(cse.GetResourceId(), &params)
+		opts := &service.ListOptions{SortField: "created_at", SortDirection: service.SortAsc}
+		collection, _, err := client.Case.Note.List(cse.GetResourceId(), opts)
 		So(err, ShouldBeNil)
 		So(collection, ShouldNotBeNil)
 		So(*collection.TotalEntries, ShouldBeGreaterThan, 0)
@@ -3,8 +3,8 @@ package integration_tests
 import (
 	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
+	service "github.com/wtlangford/go-desk/service"
 	types "github.com/wtlangford/go-desk/types"
-	"net/url"
 	"testing"
 	"time"
 )
@@ -16,10 +16,8 @@ func TestReplyIntegration(t *testing.T) {
 	client := CreateClient()
 
 	Convey("should be able to retrieve a list of case replies", t, func() {
-		listParams := url.Values{}
-		listParams.Add("sort_field", "created_at")
-		listParams.Add("sort_direction", "asc")
-		collection, _, err := client.Case.Reply.List("1", &listParams)
+		opts := &service.ListOptions{SortField: "created_at", SortDirection: service.SortAsc}
+		collection, _, err := client.Case.Reply.List("1", opts)
 		So(err, ShouldBeNil)
 		So(*collection.TotalEntries, ShouldBeGreaterThan, 0)
 		So(*collection.Embedded, ShouldNotBeNil)
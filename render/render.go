@@ -0,0 +1,297 @@
+// Package render writes resources, slices of resources and pages in the
+// formats of Format, such as YAML for reports or tables for command line tools.
+// Resources are encoded as JSON, read-only fields included, so fields are
+// named by their JSON keys, both in Options and in the output.
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	. "github.com/wtlangford/go-desk/resource"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Format is an output format of Render.
+type Format string
+
+const (
+	FormatJSON  Format = "json"
+	FormatYAML  Format = "yaml"
+	FormatTable Format = "table"
+	FormatCSV   Format = "csv"
+)
+
+// Redacted replaces the values of redacted fields.
+const Redacted = "[REDACTED]"
+
+// SensitiveFields are the fields holding personal data or message contents,
+// for use as Options.Redact.
+var SensitiveFields = []string{
+	"email",
+	"emails",
+	"phone_numbers",
+	"addresses",
+	"to",
+	"from",
+	"cc",
+	"bcc",
+	"blurb",
+	"body",
+	"body_text",
+	"body_html",
+	"message.to",
+	"message.from",
+	"message.cc",
+	"message.bcc",
+	"message.body",
+	"message.body_text",
+	"message.body_html",
+}
+
+var ErrUnknownFormat = errors.New("desk: unknown output format")
+
+// Options controls what Render outputs.
+type Options struct {
+	// Fields selects the fields to output by their dotted JSON paths, e.g.
+	// "subject" or "message.direction". Tables and CSV use them as columns,
+	// in order. By default every field but _links is output.
+	Fields []string
+	// Redact lists the dotted JSON paths of fields whose values are replaced
+	// with Redacted. A path through a list applies to every item, e.g.
+	// "emails.value".
+	Redact []string
+}
+
+// ParseFormat returns the format named name, e.g. "yaml".
+func ParseFormat(name string) (Format, error) {
+	format := Format(strings.ToLower(name))
+	switch format {
+	case FormatJSON, FormatYAML, FormatTable, FormatCSV:
+		return format, nil
+	}
+	return "", ErrUnknownFormat
+}
+
+// Render writes v in format. v is a resource, a slice of resources or a
+// *Page, whose entries are rendered as a list.
+func Render(w io.Writer, format Format, v interface{}, opts *Options) error {
+	if opts == nil {
+		opts = &Options{}
+	}
+	records, isList, err := encodeRecords(v)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		for _, path := range opts.Redact {
+			redact(record, strings.Split(path, "."))
+		}
+	}
+	switch format {
+	case FormatJSON, FormatYAML:
+		output := make([]interface{}, len(records))
+		for i, record := range records {
+			output[i] = selectFields(record, opts.Fields)
+		}
+		var value interface{} = output
+		if !isList && len(output) == 1 {
+			value = output[0]
+		}
+		if format == FormatJSON {
+			return writeJSON(w, value)
+		}
+		return writeYAML(w, value)
+	case FormatTable, FormatCSV:
+		columns := opts.Fields
+		if len(columns) == 0 {
+			columns = defaultColumns(records)
+		}
+		rows := make([][]string, len(records))
+		for i, record := range records {
+			rows[i] = make([]string, len(columns))
+			for j, column := range columns {
+				rows[i][j] = cell(lookup(record, strings.Split(column, ".")))
+			}
+		}
+		if format == FormatTable {
+			return writeTable(w, columns, rows)
+		}
+		return writeCSV(w, columns, rows)
+	}
+	return ErrUnknownFormat
+}
+
+// encodeRecords encodes v as JSON and decodes it back into one map per
+// resource. It reports whether v is a list.
+func encodeRecords(v interface{}) ([]map[string]interface{}, bool, error) {
+	var items []interface{}
+	isList := true
+	switch page := v.(type) {
+	case *Page:
+		if page.Embedded != nil {
+			items = page.Embedded.Entries
+		}
+	case Page:
+		if page.Embedded != nil {
+			items = page.Embedded.Entries
+		}
+	default:
+		value := reflect.ValueOf(v)
+		if value.Kind() == reflect.Slice || value.Kind() == reflect.Array {
+			for i := 0; i < value.Len(); i++ {
+				items = append(items, value.Index(i).Interface())
+			}
+		} else {
+			items = []interface{}{v}
+			isList = false
+		}
+	}
+	records := make([]map[string]interface{}, len(items))
+	for i, item := range items {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return nil, false, err
+		}
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		record := make(map[string]interface{})
+		err = decoder.Decode(&record)
+		if err != nil {
+			return nil, false, err
+		}
+		records[i] = record
+	}
+	return records, isList, nil
+}
+
+// redact replaces the value at path with Redacted, applying the rest of the
+// path to every item of the lists met along the way.
+func redact(value interface{}, path []string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		field, ok := v[path[0]]
+		if !ok {
+			return
+		}
+		if len(path) == 1 {
+			v[path[0]] = Redacted
+			return
+		}
+		redact(field, path[1:])
+	case []interface{}:
+		for _, item := range v {
+			redact(item, path)
+		}
+	}
+}
+
+// lookup returns the value at path, or nil if there is none. A path through
+// a list returns the values of its items.
+func lookup(value interface{}, path []string) interface{} {
+	if len(path) == 0 {
+		return value
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return lookup(v[path[0]], path[1:])
+	case []interface{}:
+		values := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			if found := lookup(item, path); found != nil {
+				values = append(values, found)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+// selectFields returns the fields of record at paths, keeping their nesting.
+// Without paths every field but _links is returned.
+func selectFields(record map[string]interface{}, paths []string) map[string]interface{} {
+	selected := make(map[string]interface{})
+	if len(paths) == 0 {
+		for name, value := range record {
+			if name != "_links" {
+				selected[name] = value
+			}
+		}
+		return selected
+	}
+	for _, path := range paths {
+		parts := strings.Split(path, ".")
+		value := lookup(record, parts)
+		if value == nil {
+			continue
+		}
+		target := selected
+		for _, part := range parts[:len(parts)-1] {
+			next, ok := target[part].(map[string]interface{})
+			if !ok {
+				next = make(map[string]interface{})
+				target[part] = next
+			}
+			target = next
+		}
+		target[parts[len(parts)-1]] = value
+	}
+	return selected
+}
+
+// defaultColumns returns the top level fields of records but _links, sorted.
+func defaultColumns(records []map[string]interface{}) []string {
+	seen := make(map[string]bool)
+	columns := make([]string, 0)
+	for _, record := range records {
+		for name := range record {
+			if name != "_links" && !seen[name] {
+				seen[name] = true
+				columns = append(columns, name)
+			}
+		}
+	}
+	sort.Strings(columns)
+	return columns
+}
+
+// cell formats a value for a table or CSV cell. Lists of plain values are
+// joined with commas while objects are written as JSON.
+func cell(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return fmt.Sprint(v)
+	case []interface{}:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			if _, ok := item.(map[string]interface{}); ok {
+				data, _ := json.Marshal(v)
+				return string(data)
+			}
+			parts[i] = cell(item)
+		}
+		return strings.Join(parts, ",")
+	}
+	data, _ := json.Marshal(value)
+	return string(data)
+}
+
+func writeJSON(w io.Writer, value interface{}) error {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	_, err = w.Write(data)
+	return err
+}
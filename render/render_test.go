@@ -0,0 +1,161 @@
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	. "github.com/wtlangford/go-desk/resource"
+	. "github.com/wtlangford/go-desk/types"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func newCase(id int, subject string) *Case {
+	cse := NewCase()
+	data := fmt.Sprintf(`{"id":%d,"subject":%q,"status":"open","priority":4,`+
+		`"labels":["vip","billing"],"created_at":"2015-03-02T10:00:00Z",`+
+		`"message":{"direction":"in","body":"secret text"},`+
+		`"_links":{"self":{"href":"/api/v2/cases/%d","class":"case"}}}`, id, subject, id)
+	err := json.Unmarshal([]byte(data), cse)
+	if err != nil {
+		panic(err)
+	}
+	return cse
+}
+
+func newPage(cases ...*Case) *Page {
+	entries := make([]interface{}, len(cases))
+	for i, cse := range cases {
+		entries[i] = cse
+	}
+	return &Page{Embedded: &EntryCollection{Entries: entries}}
+}
+
+func render(format Format, v interface{}, opts *Options) string {
+	var buf bytes.Buffer
+	err := Render(&buf, format, v, opts)
+	So(err, ShouldBeNil)
+	return buf.String()
+}
+
+func TestRender(t *testing.T) {
+	fmt.Println("")
+	Convey("ParseFormat", t, func() {
+		format, err := ParseFormat("YAML")
+		So(err, ShouldBeNil)
+		So(format, ShouldEqual, FormatYAML)
+		_, err = ParseFormat("xml")
+		So(err, ShouldEqual, ErrUnknownFormat)
+		So(Render(&bytes.Buffer{}, Format("xml"), newCase(1, "Hello"), nil), ShouldEqual, ErrUnknownFormat)
+	})
+	Convey("JSON", t, func() {
+		Convey("should indent a resource without its links", func() {
+			out := render(FormatJSON, newCase(1, "Hello"), nil)
+			So(out, ShouldContainSubstring, "\n  \"subject\": \"Hello\"\n")
+			So(out, ShouldContainSubstring, "\"created_at\": \"2015-03-02T10:00:00Z\"")
+			So(out, ShouldNotContainSubstring, "_links")
+			So(strings.HasPrefix(out, "{"), ShouldBeTrue)
+		})
+		Convey("should select nested fields", func() {
+			out := render(FormatJSON, newCase(1, "Hello"), &Options{Fields: []string{"id", "message.direction"}})
+			var decoded map[string]interface{}
+			So(json.Unmarshal([]byte(out), &decoded), ShouldBeNil)
+			So(decoded, ShouldResemble, map[string]interface{}{
+				"id":      float64(1),
+				"message": map[string]interface{}{"direction": "in"},
+			})
+		})
+		Convey("should render the entries of a page as a list", func() {
+			out := render(FormatJSON, newPage(newCase(1, "Hello"), newCase(2, "World")), &Options{Fields: []string{"id"}})
+			var decoded []map[string]interface{}
+			So(json.Unmarshal([]byte(out), &decoded), ShouldBeNil)
+			So(decoded, ShouldResemble, []map[string]interface{}{{"id": float64(1)}, {"id": float64(2)}})
+		})
+		Convey("should render an empty page as an empty list", func() {
+			So(render(FormatJSON, &Page{}, nil), ShouldEqual, "[]\n")
+		})
+	})
+	Convey("Redaction", t, func() {
+		Convey("should replace sensitive fields", func() {
+			out := render(FormatJSON, newCase(1, "Hello"), &Options{Redact: SensitiveFields})
+			So(out, ShouldNotContainSubstring, "secret text")
+			So(out, ShouldContainSubstring, "\"body\": \""+Redacted+"\"")
+		})
+		Convey("should replace the email addresses of users and replies", func() {
+			user := NewUser()
+			user.Name = String("Ada")
+			user.Email = String("ada@example.com")
+			out := render(FormatJSON, user, &Options{Redact: SensitiveFields})
+			So(out, ShouldNotContainSubstring, "ada@example.com")
+			So(out, ShouldContainSubstring, "\"name\": \"Ada\"")
+
+			reply := NewReply()
+			reply.To = String("jane@example.com")
+			reply.From = String("support@example.com")
+			reply.Cc = String("boss@example.com")
+			reply.Bcc = String("audit@example.com")
+			reply.Body = String("Thanks")
+			out = render(FormatCSV, reply, &Options{Fields: []string{"to", "from", "cc", "bcc", "body"}, Redact: SensitiveFields})
+			So(out, ShouldNotContainSubstring, "@example.com")
+			So(out, ShouldNotContainSubstring, "Thanks")
+		})
+		Convey("should replace the addresses of the message of a case", func() {
+			cse := newCase(1, "Hello")
+			cse.Message.To = String("jane@example.com")
+			cse.Message.From = String("support@example.com")
+			out := render(FormatJSON, cse, &Options{Redact: SensitiveFields})
+			So(out, ShouldNotContainSubstring, "@example.com")
+		})
+		Convey("should replace the blurb of a case", func() {
+			cse := newCase(1, "Hello")
+			cse.Blurb = String("secret excerpt")
+			out := render(FormatTable, cse, &Options{Fields: []string{"subject", "blurb"}, Redact: SensitiveFields})
+			So(out, ShouldNotContainSubstring, "secret")
+			So(out, ShouldContainSubstring, Redacted)
+		})
+		Convey("should apply to every item of a list", func() {
+			customer := NewCustomer()
+			customer.AddEmail("jane@example.com", ContactWork)
+			customer.AddEmail("jane@home.example.com", ContactHome)
+			out := render(FormatCSV, customer, &Options{Fields: []string{"emails.type", "emails.value"}, Redact: []string{"emails.value"}})
+			So(out, ShouldEqual, "emails.type,emails.value\n\"work,home\",\"[REDACTED],[REDACTED]\"\n")
+		})
+	})
+	Convey("YAML", t, func() {
+		Convey("should write a resource", func() {
+			out := render(FormatYAML, newCase(1, "2015"), &Options{Fields: []string{"id", "subject", "labels", "message.direction"}})
+			So(out, ShouldEqual, "id: 1\nlabels:\n- vip\n- billing\nmessage:\n  direction: in\nsubject: \"2015\"\n")
+		})
+		Convey("should write a list of objects", func() {
+			out := render(FormatYAML, []*Case{newCase(1, "Hello: there"), newCase(2, "World")}, &Options{Fields: []string{"id", "subject"}})
+			So(out, ShouldEqual, "- id: 1\n  subject: \"Hello: there\"\n- id: 2\n  subject: World\n")
+		})
+		Convey("should quote strings read as timestamps, numbers or booleans", func() {
+			for _, subject := range []string{"2024-01-01", "2024-01-01 10:00:00", "0x1F", "0o17", "017", ".inf", "-.Inf", ".NaN", "y", "N"} {
+				out := render(FormatYAML, newCase(1, subject), &Options{Fields: []string{"subject"}})
+				So(out, ShouldEqual, "subject: "+strconv.Quote(subject)+"\n")
+			}
+			out := render(FormatYAML, newCase(1, "yo"), &Options{Fields: []string{"subject"}})
+			So(out, ShouldEqual, "subject: yo\n")
+		})
+	})
+	Convey("Table", t, func() {
+		Convey("should align the selected columns", func() {
+			out := render(FormatTable, newPage(newCase(1, "Hello"), newCase(22, "World")), &Options{Fields: []string{"id", "subject", "status"}})
+			So(out, ShouldEqual, "ID  SUBJECT  STATUS\n1   Hello    open\n22  World    open\n")
+		})
+		Convey("should default to the sorted top level fields", func() {
+			out := render(FormatTable, newCase(1, "Hello"), nil)
+			header := strings.Fields(strings.Split(out, "\n")[0])
+			So(header, ShouldResemble, []string{"CREATED_AT", "ID", "LABELS", "MESSAGE", "PRIORITY", "STATUS", "SUBJECT"})
+		})
+	})
+	Convey("CSV", t, func() {
+		Convey("should quote cells when needed", func() {
+			out := render(FormatCSV, []*Case{newCase(1, "Hello, \"you\"")}, &Options{Fields: []string{"id", "subject", "labels", "missing"}})
+			So(out, ShouldEqual, "id,subject,labels,missing\n1,\"Hello, \"\"you\"\"\",\"vip,billing\",\n")
+		})
+	})
+}
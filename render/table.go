@@ -0,0 +1,46 @@
+package render
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// writeTable writes rows aligned in columns under a header of the upper case
+// column names.
+func writeTable(w io.Writer, columns []string, rows [][]string) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = strings.ToUpper(column)
+	}
+	writeTableRow(tw, header)
+	for _, row := range rows {
+		writeTableRow(tw, row)
+	}
+	return tw.Flush()
+}
+
+func writeTableRow(w io.Writer, row []string) {
+	cells := make([]string, len(row))
+	for i, value := range row {
+		// tabs and newlines would break the alignment
+		cells[i] = strings.NewReplacer("\t", " ", "\n", " ", "\r", "").Replace(value)
+	}
+	io.WriteString(w, strings.Join(cells, "\t")+"\n")
+}
+
+// writeCSV writes rows as CSV records under a header of the column names.
+func writeCSV(w io.Writer, columns []string, rows [][]string) error {
+	cw := csv.NewWriter(w)
+	err := cw.Write(columns)
+	if err != nil {
+		return err
+	}
+	err = cw.WriteAll(rows)
+	if err != nil {
+		return err
+	}
+	return cw.Error()
+}
@@ -0,0 +1,139 @@
+package render
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// writeYAML writes value, made of the types decoded from JSON, as a YAML
+// document. Keys are sorted and strings are quoted when they would otherwise
+// read as another type.
+func writeYAML(w io.Writer, value interface{}) error {
+	buf := bufio.NewWriter(w)
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 {
+			buf.WriteString("{}\n")
+		} else {
+			writeYAMLMap(buf, v, 0, false)
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			buf.WriteString("[]\n")
+		} else {
+			writeYAMLList(buf, v, 0)
+		}
+	default:
+		buf.WriteString(yamlScalar(v) + "\n")
+	}
+	return buf.Flush()
+}
+
+// writeYAMLMap writes the keys of m at indent. Within a list item the first
+// key follows the dash and is not indented.
+func writeYAMLMap(buf *bufio.Writer, m map[string]interface{}, indent int, listItem bool) {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for i, key := range keys {
+		if i > 0 || !listItem {
+			buf.WriteString(strings.Repeat(" ", indent))
+		}
+		buf.WriteString(yamlString(key) + ":")
+		writeYAMLValue(buf, m[key], indent)
+	}
+}
+
+func writeYAMLList(buf *bufio.Writer, list []interface{}, indent int) {
+	for _, item := range list {
+		buf.WriteString(strings.Repeat(" ", indent) + "- ")
+		switch v := item.(type) {
+		case map[string]interface{}:
+			if len(v) == 0 {
+				buf.WriteString("{}\n")
+			} else {
+				writeYAMLMap(buf, v, indent+2, true)
+			}
+		case []interface{}:
+			if len(v) == 0 {
+				buf.WriteString("[]\n")
+			} else {
+				buf.WriteString("\n")
+				writeYAMLList(buf, v, indent+2)
+			}
+		default:
+			buf.WriteString(yamlScalar(v) + "\n")
+		}
+	}
+}
+
+// writeYAMLValue writes the value of a map key, after its colon.
+func writeYAMLValue(buf *bufio.Writer, value interface{}, indent int) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 {
+			buf.WriteString(" {}\n")
+			return
+		}
+		buf.WriteString("\n")
+		writeYAMLMap(buf, v, indent+2, false)
+	case []interface{}:
+		if len(v) == 0 {
+			buf.WriteString(" []\n")
+			return
+		}
+		buf.WriteString("\n")
+		writeYAMLList(buf, v, indent)
+	default:
+		buf.WriteString(" " + yamlScalar(v) + "\n")
+	}
+}
+
+func yamlScalar(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(v)
+	case json.Number:
+		return v.String()
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case string:
+		return yamlString(v)
+	}
+	data, _ := json.Marshal(value)
+	return string(data)
+}
+
+// yamlImplicit matches the plain scalars YAML 1.1 readers resolve to another
+// type than string and ParseFloat does not catch: timestamps, hexadecimal,
+// octal and binary integers, infinities, not-a-number and the single letter
+// booleans.
+var yamlImplicit = regexp.MustCompile(`^(?:[0-9]{4}-[0-9]{1,2}-[0-9]{1,2}(?:(?:[Tt]|[ \t]+).*)?` +
+	`|[-+]?0[xX][0-9a-fA-F_]+|[-+]?0[oO]?[0-7_]+|[-+]?0[bB][01_]+` +
+	`|[-+]?\.(?:inf|Inf|INF)|\.(?:nan|NaN|NAN)|[yYnN])$`)
+
+// yamlString returns s, quoted when it is empty, could read as a number,
+// boolean, timestamp or null, or holds characters with a meaning in YAML.
+func yamlString(s string) string {
+	if s == "" || strings.ContainsAny(s, ":#{}[],&*!|>'\"%@`\n\t\\") ||
+		strings.TrimSpace(s) != s || strings.HasPrefix(s, "-") || strings.HasPrefix(s, "?") {
+		return strconv.Quote(s)
+	}
+	switch strings.ToLower(s) {
+	case "true", "false", "yes", "no", "on", "off", "null", "~":
+		return strconv.Quote(s)
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil || yamlImplicit.MatchString(s) {
+		return strconv.Quote(s)
+	}
+	return s
+}
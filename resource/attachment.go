@@ -8,14 +8,14 @@ import (
 )
 
 type Attachment struct {
-	Size        *int       `json:"size,omitempty"`
+	Size        *int       `json:"size,omitempty" desk:"readonly"`
 	FileName    *string    `json:"file_name,omitempty"`
 	ContentType *string    `json:"content_type,omitempty"`
 	Content     *string    `json:"content,omitempty"`
-	URL         *string    `json:"url,omitempty"`
-	ErasedAt    *Timestamp `json:"erased_at,omitempty"`
-	CreatedAt   *Timestamp `json:"created_at,omitempty"`
-	UpdatedAt   *Timestamp `json:"updated_at,omitempty"`
+	URL         *string    `json:"url,omitempty" desk:"readonly"`
+	ErasedAt    *Timestamp `json:"erased_at,omitempty" desk:"readonly"`
+	CreatedAt   *Timestamp `json:"created_at,omitempty" desk:"readonly"`
+	UpdatedAt   *Timestamp `json:"updated_at,omitempty" desk:"readonly"`
 	Resource
 }
 
@@ -41,3 +41,13 @@ func (r *Attachment) SetContent(fileName string) error {
 func (r Attachment) String() string {
 	return Stringify(r)
 }
+
+// Validate checks the attachment before it is written in op. A new
+// attachment needs a file name, a content type and its content.
+func (r *Attachment) Validate(op Operation) error {
+	errs := make(ValidationErrors)
+	errs.requireString("file_name", r.FileName, op)
+	errs.requireString("content_type", r.ContentType, op)
+	errs.requireString("content", r.Content, op)
+	return errs.Err()
+}
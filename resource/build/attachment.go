@@ -0,0 +1,57 @@
+package build
+
+import (
+	"encoding/base64"
+	"github.com/wtlangford/go-desk/resource"
+	. "github.com/wtlangford/go-desk/types"
+)
+
+// AttachmentBuilder builds a *resource.Attachment.
+type AttachmentBuilder struct {
+	steps []func(*resource.Attachment)
+}
+
+// Attachment returns an empty AttachmentBuilder.
+func Attachment() AttachmentBuilder {
+	return AttachmentBuilder{}
+}
+
+func (b AttachmentBuilder) with(step func(*resource.Attachment)) AttachmentBuilder {
+	b.steps = append(b.steps[:len(b.steps):len(b.steps)], step)
+	return b
+}
+
+// Build returns a new attachment with every value set on the builder.
+func (b AttachmentBuilder) Build() *resource.Attachment {
+	a := resource.NewAttachment()
+	for _, step := range b.steps {
+		step(a)
+	}
+	return a
+}
+
+func (b AttachmentBuilder) FileName(value string) AttachmentBuilder {
+	return b.with(func(a *resource.Attachment) {
+		a.FileName = String(value)
+	})
+}
+
+func (b AttachmentBuilder) ContentType(value string) AttachmentBuilder {
+	return b.with(func(a *resource.Attachment) {
+		a.ContentType = String(value)
+	})
+}
+
+// Link adds a link named name to a resource of class at href.
+func (b AttachmentBuilder) Link(name string, class string, href string) AttachmentBuilder {
+	return b.with(func(a *resource.Attachment) {
+		a.AddHrefLinkWithClass(name, class, href)
+	})
+}
+
+// Content sets the content of the attachment, encoding data as base64.
+func (b AttachmentBuilder) Content(data []byte) AttachmentBuilder {
+	return b.with(func(a *resource.Attachment) {
+		a.Content = String(base64.StdEncoding.EncodeToString(data))
+	})
+}
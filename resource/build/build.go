@@ -0,0 +1,10 @@
+// Package build provides builders for the resources written to Desk, with a
+// typed setter for every writable field. Setters return a new builder, so a
+// builder can be shared and extended:
+//
+//	base := build.Case().Type(resource.CaseTypeEmail).Priority(4)
+//	cse := base.Subject("Help").Customer(customer).Build()
+//
+// Resources nested with setters such as CaseBuilder.Message are shared by
+// every resource built.
+package build
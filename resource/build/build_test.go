@@ -0,0 +1,78 @@
+package build
+
+import (
+	"encoding/json"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/wtlangford/go-desk/resource"
+	"testing"
+	"time"
+)
+
+func TestBuilders(t *testing.T) {
+	fmt.Println("")
+	Convey("CaseBuilder", t, func() {
+		Convey("should set typed fields", func() {
+			until := time.Date(2015, 3, 1, 10, 0, 0, 0, time.UTC)
+			cse := Case().
+				Type(resource.CaseTypeEmail).
+				Status(resource.CaseStatusNew).
+				Subject("Help").
+				Priority(4).
+				Labels("vip").
+				Labels("billing").
+				LockedUntil(until).
+				CustomField("tier", "gold").
+				Build()
+			So(*cse.Type, ShouldEqual, resource.CaseTypeEmail)
+			So(*cse.Status, ShouldEqual, resource.CaseStatusNew)
+			So(*cse.Subject, ShouldEqual, "Help")
+			So(*cse.Priority, ShouldEqual, 4)
+			So(cse.Labels, ShouldResemble, []string{"vip", "billing"})
+			So(cse.LockedUntil.Time, ShouldResemble, until)
+			So(cse.CustomFields["tier"], ShouldEqual, "gold")
+		})
+		Convey("should set links and the message", func() {
+			customer := resource.NewCustomer()
+			customer.SetResourceId("7")
+			message := Message().Direction(resource.DirectionIn).Body("Please help").Build()
+			cse := Case().Customer(customer).Message(message).Link("macro", "macro", "/api/v2/macros/3").Build()
+			data, err := json.Marshal(cse)
+			So(err, ShouldBeNil)
+			So(string(data), ShouldEqual, `{"message":{"direction":"in","body":"Please help"},"_links":{"customer":{"class":"customer","href":"/api/v2/customers/7"},"macro":{"class":"macro","href":"/api/v2/macros/3"}}}`)
+		})
+		Convey("should not change shared builders", func() {
+			base := Case().Priority(4)
+			first := base.Subject("first")
+			second := base.Subject("second")
+			So(*first.Build().Subject, ShouldEqual, "first")
+			So(*second.Build().Subject, ShouldEqual, "second")
+			So(base.Build().Subject, ShouldBeNil)
+			So(*base.Build().Priority, ShouldEqual, 4)
+		})
+		Convey("should build initialized resources", func() {
+			user := User().Name("Ada").Build()
+			user.SetResourceId("5")
+			cse := Case().AssignedUser(user).Build()
+			So(cse.GetHrefLink("assigned_user"), ShouldEqual, "/api/v2/users/5")
+		})
+	})
+	Convey("CustomerBuilder", t, func() {
+		Convey("should add contacts", func() {
+			customer := Customer().
+				FirstName("Ada").
+				Email("ada@example.com", resource.ContactWork).
+				PhoneNumber("555-0100", resource.ContactMobile).
+				Build()
+			So(*customer.FirstName, ShouldEqual, "Ada")
+			So(customer.Emails[0].Value, ShouldEqual, "ada@example.com")
+			So(customer.PhoneNumbers[0].Type, ShouldEqual, resource.ContactMobile)
+		})
+	})
+	Convey("AttachmentBuilder", t, func() {
+		Convey("should encode the content", func() {
+			attachment := Attachment().FileName("hello.txt").Content([]byte("hello")).Build()
+			So(*attachment.Content, ShouldEqual, "aGVsbG8=")
+		})
+	})
+}
@@ -0,0 +1,150 @@
+package build
+
+import (
+	"github.com/wtlangford/go-desk/resource"
+	. "github.com/wtlangford/go-desk/types"
+	"time"
+)
+
+// CaseBuilder builds a *resource.Case.
+type CaseBuilder struct {
+	steps []func(*resource.Case)
+}
+
+// Case returns an empty CaseBuilder.
+func Case() CaseBuilder {
+	return CaseBuilder{}
+}
+
+func (b CaseBuilder) with(step func(*resource.Case)) CaseBuilder {
+	b.steps = append(b.steps[:len(b.steps):len(b.steps)], step)
+	return b
+}
+
+// Build returns a new case with every value set on the builder.
+func (b CaseBuilder) Build() *resource.Case {
+	c := resource.NewCase()
+	for _, step := range b.steps {
+		step(c)
+	}
+	return c
+}
+
+func (b CaseBuilder) ExternalID(value string) CaseBuilder {
+	return b.with(func(c *resource.Case) {
+		c.ExternalID = String(value)
+	})
+}
+
+func (b CaseBuilder) Type(value resource.CaseType) CaseBuilder {
+	return b.with(func(c *resource.Case) {
+		c.Type = value.Ptr()
+	})
+}
+
+func (b CaseBuilder) Status(value resource.CaseStatus) CaseBuilder {
+	return b.with(func(c *resource.Case) {
+		c.Status = value.Ptr()
+	})
+}
+
+func (b CaseBuilder) Subject(value string) CaseBuilder {
+	return b.with(func(c *resource.Case) {
+		c.Subject = String(value)
+	})
+}
+
+func (b CaseBuilder) Description(value string) CaseBuilder {
+	return b.with(func(c *resource.Case) {
+		c.Description = String(value)
+	})
+}
+
+func (b CaseBuilder) Language(value string) CaseBuilder {
+	return b.with(func(c *resource.Case) {
+		c.Language = String(value)
+	})
+}
+
+func (b CaseBuilder) Priority(value int) CaseBuilder {
+	return b.with(func(c *resource.Case) {
+		c.Priority = Integer(value)
+	})
+}
+
+// Labels adds labels to the case.
+func (b CaseBuilder) Labels(values ...string) CaseBuilder {
+	return b.with(func(c *resource.Case) {
+		c.Labels = append(c.Labels, values...)
+	})
+}
+
+// LabelIDs adds labels to the case by their IDs.
+func (b CaseBuilder) LabelIDs(values ...int) CaseBuilder {
+	return b.with(func(c *resource.Case) {
+		c.LabelIDs = append(c.LabelIDs, values...)
+	})
+}
+
+func (b CaseBuilder) LabelAction(value string) CaseBuilder {
+	return b.with(func(c *resource.Case) {
+		c.LabelAction = String(value)
+	})
+}
+
+func (b CaseBuilder) SuppressRules(value bool) CaseBuilder {
+	return b.with(func(c *resource.Case) {
+		c.SuppressRules = Boolean(value)
+	})
+}
+
+func (b CaseBuilder) LockedUntil(value time.Time) CaseBuilder {
+	return b.with(func(c *resource.Case) {
+		c.LockedUntil = &Timestamp{Time: value}
+	})
+}
+
+// Link adds a link named name to a resource of class at href.
+func (b CaseBuilder) Link(name string, class string, href string) CaseBuilder {
+	return b.with(func(c *resource.Case) {
+		c.AddHrefLinkWithClass(name, class, href)
+	})
+}
+
+// CustomField sets the value of a custom field.
+func (b CaseBuilder) CustomField(name string, value interface{}) CaseBuilder {
+	return b.with(func(c *resource.Case) {
+		if c.CustomFields == nil {
+			c.CustomFields = make(map[string]interface{})
+		}
+		c.CustomFields[name] = value
+	})
+}
+
+// Message sets the message a new case is created with.
+func (b CaseBuilder) Message(message *resource.Message) CaseBuilder {
+	return b.with(func(c *resource.Case) {
+		c.Message = message
+	})
+}
+
+// Customer links the case to a customer.
+func (b CaseBuilder) Customer(customer *resource.Customer) CaseBuilder {
+	return b.with(func(c *resource.Case) {
+		c.SetCustomer(customer)
+	})
+}
+
+// AssignedUser assigns the case to a user.
+func (b CaseBuilder) AssignedUser(user *resource.User) CaseBuilder {
+	return b.with(func(c *resource.Case) {
+		c.SetAssignedUser(user)
+	})
+}
+
+// AssignedGroup assigns the case to a group.
+func (b CaseBuilder) AssignedGroup(group *resource.Group) CaseBuilder {
+	return b.with(func(c *resource.Case) {
+		c.SetAssignedGroup(group)
+	})
+}
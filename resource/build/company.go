@@ -0,0 +1,66 @@
+package build
+
+import (
+	"github.com/wtlangford/go-desk/resource"
+	. "github.com/wtlangford/go-desk/types"
+)
+
+// CompanyBuilder builds a *resource.Company.
+type CompanyBuilder struct {
+	steps []func(*resource.Company)
+}
+
+// Company returns an empty CompanyBuilder.
+func Company() CompanyBuilder {
+	return CompanyBuilder{}
+}
+
+func (b CompanyBuilder) with(step func(*resource.Company)) CompanyBuilder {
+	b.steps = append(b.steps[:len(b.steps):len(b.steps)], step)
+	return b
+}
+
+// Build returns a new company with every value set on the builder.
+func (b CompanyBuilder) Build() *resource.Company {
+	c := resource.NewCompany()
+	for _, step := range b.steps {
+		step(c)
+	}
+	return c
+}
+
+func (b CompanyBuilder) ExternalID(value string) CompanyBuilder {
+	return b.with(func(c *resource.Company) {
+		c.ExternalID = String(value)
+	})
+}
+
+func (b CompanyBuilder) Name(value string) CompanyBuilder {
+	return b.with(func(c *resource.Company) {
+		c.Name = String(value)
+	})
+}
+
+// Domains adds domains to the company.
+func (b CompanyBuilder) Domains(values ...string) CompanyBuilder {
+	return b.with(func(c *resource.Company) {
+		c.Domains = append(c.Domains, values...)
+	})
+}
+
+// Link adds a link named name to a resource of class at href.
+func (b CompanyBuilder) Link(name string, class string, href string) CompanyBuilder {
+	return b.with(func(c *resource.Company) {
+		c.AddHrefLinkWithClass(name, class, href)
+	})
+}
+
+// CustomField sets the value of a custom field.
+func (b CompanyBuilder) CustomField(name string, value interface{}) CompanyBuilder {
+	return b.with(func(c *resource.Company) {
+		if c.CustomFields == nil {
+			c.CustomFields = make(map[string]interface{})
+		}
+		c.CustomFields[name] = value
+	})
+}
@@ -0,0 +1,117 @@
+package build
+
+import (
+	"github.com/wtlangford/go-desk/resource"
+	. "github.com/wtlangford/go-desk/types"
+	"time"
+)
+
+// CustomerBuilder builds a *resource.Customer.
+type CustomerBuilder struct {
+	steps []func(*resource.Customer)
+}
+
+// Customer returns an empty CustomerBuilder.
+func Customer() CustomerBuilder {
+	return CustomerBuilder{}
+}
+
+func (b CustomerBuilder) with(step func(*resource.Customer)) CustomerBuilder {
+	b.steps = append(b.steps[:len(b.steps):len(b.steps)], step)
+	return b
+}
+
+// Build returns a new customer with every value set on the builder.
+func (b CustomerBuilder) Build() *resource.Customer {
+	c := resource.NewCustomer()
+	for _, step := range b.steps {
+		step(c)
+	}
+	return c
+}
+
+func (b CustomerBuilder) ExternalID(value string) CustomerBuilder {
+	return b.with(func(c *resource.Customer) {
+		c.ExternalID = String(value)
+	})
+}
+
+func (b CustomerBuilder) FirstName(value string) CustomerBuilder {
+	return b.with(func(c *resource.Customer) {
+		c.FirstName = String(value)
+	})
+}
+
+func (b CustomerBuilder) LastName(value string) CustomerBuilder {
+	return b.with(func(c *resource.Customer) {
+		c.LastName = String(value)
+	})
+}
+
+func (b CustomerBuilder) Company(value string) CustomerBuilder {
+	return b.with(func(c *resource.Customer) {
+		c.Company = String(value)
+	})
+}
+
+func (b CustomerBuilder) Title(value string) CustomerBuilder {
+	return b.with(func(c *resource.Customer) {
+		c.Title = String(value)
+	})
+}
+
+func (b CustomerBuilder) Background(value string) CustomerBuilder {
+	return b.with(func(c *resource.Customer) {
+		c.Background = String(value)
+	})
+}
+
+func (b CustomerBuilder) Language(value string) CustomerBuilder {
+	return b.with(func(c *resource.Customer) {
+		c.Language = String(value)
+	})
+}
+
+func (b CustomerBuilder) LockedUntil(value time.Time) CustomerBuilder {
+	return b.with(func(c *resource.Customer) {
+		c.LockedUntil = &Timestamp{Time: value}
+	})
+}
+
+// Link adds a link named name to a resource of class at href.
+func (b CustomerBuilder) Link(name string, class string, href string) CustomerBuilder {
+	return b.with(func(c *resource.Customer) {
+		c.AddHrefLinkWithClass(name, class, href)
+	})
+}
+
+// CustomField sets the value of a custom field.
+func (b CustomerBuilder) CustomField(name string, value interface{}) CustomerBuilder {
+	return b.with(func(c *resource.Customer) {
+		if c.CustomFields == nil {
+			c.CustomFields = make(map[string]interface{})
+		}
+		c.CustomFields[name] = value
+	})
+}
+
+// Email adds an email address of type valueType, e.g. resource.ContactWork.
+func (b CustomerBuilder) Email(value string, valueType resource.ContactType) CustomerBuilder {
+	return b.with(func(c *resource.Customer) {
+		c.AddEmail(value, valueType)
+	})
+}
+
+// PhoneNumber adds a phone number of type valueType, e.g. resource.ContactMobile.
+func (b CustomerBuilder) PhoneNumber(value string, valueType resource.ContactType) CustomerBuilder {
+	return b.with(func(c *resource.Customer) {
+		c.AddPhoneNumber(value, valueType)
+	})
+}
+
+// Address adds a postal address of type valueType, e.g. resource.ContactHome.
+func (b CustomerBuilder) Address(value string, valueType resource.ContactType) CustomerBuilder {
+	return b.with(func(c *resource.Customer) {
+		c.AddAddress(value, valueType)
+	})
+}
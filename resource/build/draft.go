@@ -0,0 +1,121 @@
+package build
+
+import (
+	"github.com/wtlangford/go-desk/resource"
+	. "github.com/wtlangford/go-desk/types"
+)
+
+// DraftBuilder builds a *resource.Draft.
+type DraftBuilder struct {
+	steps []func(*resource.Draft)
+}
+
+// Draft returns an empty DraftBuilder.
+func Draft() DraftBuilder {
+	return DraftBuilder{}
+}
+
+func (b DraftBuilder) with(step func(*resource.Draft)) DraftBuilder {
+	b.steps = append(b.steps[:len(b.steps):len(b.steps)], step)
+	return b
+}
+
+// Build returns a new draft with every value set on the builder.
+func (b DraftBuilder) Build() *resource.Draft {
+	d := resource.NewDraft()
+	for _, step := range b.steps {
+		step(d)
+	}
+	return d
+}
+
+func (b DraftBuilder) Direction(value resource.Direction) DraftBuilder {
+	return b.with(func(d *resource.Draft) {
+		d.Direction = value.Ptr()
+	})
+}
+
+func (b DraftBuilder) Status(value resource.ReplyStatus) DraftBuilder {
+	return b.with(func(d *resource.Draft) {
+		d.Status = value.Ptr()
+	})
+}
+
+func (b DraftBuilder) Subject(value string) DraftBuilder {
+	return b.with(func(d *resource.Draft) {
+		d.Subject = String(value)
+	})
+}
+
+func (b DraftBuilder) Type(value string) DraftBuilder {
+	return b.with(func(d *resource.Draft) {
+		d.Type = String(value)
+	})
+}
+
+func (b DraftBuilder) Body(value string) DraftBuilder {
+	return b.with(func(d *resource.Draft) {
+		d.Body = String(value)
+	})
+}
+
+func (b DraftBuilder) BodyText(value string) DraftBuilder {
+	return b.with(func(d *resource.Draft) {
+		d.BodyText = String(value)
+	})
+}
+
+func (b DraftBuilder) BodyHtml(value string) DraftBuilder {
+	return b.with(func(d *resource.Draft) {
+		d.BodyHtml = String(value)
+	})
+}
+
+func (b DraftBuilder) To(value string) DraftBuilder {
+	return b.with(func(d *resource.Draft) {
+		d.To = String(value)
+	})
+}
+
+func (b DraftBuilder) From(value string) DraftBuilder {
+	return b.with(func(d *resource.Draft) {
+		d.From = String(value)
+	})
+}
+
+func (b DraftBuilder) Cc(value string) DraftBuilder {
+	return b.with(func(d *resource.Draft) {
+		d.Cc = String(value)
+	})
+}
+
+func (b DraftBuilder) Bcc(value string) DraftBuilder {
+	return b.with(func(d *resource.Draft) {
+		d.Bcc = String(value)
+	})
+}
+
+func (b DraftBuilder) Headers(value string) DraftBuilder {
+	return b.with(func(d *resource.Draft) {
+		d.Headers = String(value)
+	})
+}
+
+func (b DraftBuilder) HeadersRaw(value string) DraftBuilder {
+	return b.with(func(d *resource.Draft) {
+		d.HeadersRaw = String(value)
+	})
+}
+
+func (b DraftBuilder) FromFacebookName(value string) DraftBuilder {
+	return b.with(func(d *resource.Draft) {
+		d.FromFacebookName = String(value)
+	})
+}
+
+// Link adds a link named name to a resource of class at href.
+func (b DraftBuilder) Link(name string, class string, href string) DraftBuilder {
+	return b.with(func(d *resource.Draft) {
+		d.AddHrefLinkWithClass(name, class, href)
+	})
+}
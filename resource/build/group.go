@@ -0,0 +1,43 @@
+package build
+
+import (
+	"github.com/wtlangford/go-desk/resource"
+	. "github.com/wtlangford/go-desk/types"
+)
+
+// GroupBuilder builds a *resource.Group.
+type GroupBuilder struct {
+	steps []func(*resource.Group)
+}
+
+// Group returns an empty GroupBuilder.
+func Group() GroupBuilder {
+	return GroupBuilder{}
+}
+
+func (b GroupBuilder) with(step func(*resource.Group)) GroupBuilder {
+	b.steps = append(b.steps[:len(b.steps):len(b.steps)], step)
+	return b
+}
+
+// Build returns a new group with every value set on the builder.
+func (b GroupBuilder) Build() *resource.Group {
+	g := resource.NewGroup()
+	for _, step := range b.steps {
+		step(g)
+	}
+	return g
+}
+
+func (b GroupBuilder) Name(value string) GroupBuilder {
+	return b.with(func(g *resource.Group) {
+		g.Name = String(value)
+	})
+}
+
+// Link adds a link named name to a resource of class at href.
+func (b GroupBuilder) Link(name string, class string, href string) GroupBuilder {
+	return b.with(func(g *resource.Group) {
+		g.AddHrefLinkWithClass(name, class, href)
+	})
+}
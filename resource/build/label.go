@@ -0,0 +1,80 @@
+package build
+
+import (
+	"github.com/wtlangford/go-desk/resource"
+	. "github.com/wtlangford/go-desk/types"
+)
+
+// LabelBuilder builds a *resource.Label.
+type LabelBuilder struct {
+	steps []func(*resource.Label)
+}
+
+// Label returns an empty LabelBuilder.
+func Label() LabelBuilder {
+	return LabelBuilder{}
+}
+
+func (b LabelBuilder) with(step func(*resource.Label)) LabelBuilder {
+	b.steps = append(b.steps[:len(b.steps):len(b.steps)], step)
+	return b
+}
+
+// Build returns a new label with every value set on the builder.
+func (b LabelBuilder) Build() *resource.Label {
+	l := resource.NewLabel()
+	for _, step := range b.steps {
+		step(l)
+	}
+	return l
+}
+
+func (b LabelBuilder) Name(value string) LabelBuilder {
+	return b.with(func(l *resource.Label) {
+		l.Name = String(value)
+	})
+}
+
+func (b LabelBuilder) Description(value string) LabelBuilder {
+	return b.with(func(l *resource.Label) {
+		l.Description = String(value)
+	})
+}
+
+func (b LabelBuilder) Color(value string) LabelBuilder {
+	return b.with(func(l *resource.Label) {
+		l.Color = String(value)
+	})
+}
+
+func (b LabelBuilder) Enabled(value bool) LabelBuilder {
+	return b.with(func(l *resource.Label) {
+		l.Enabled = Boolean(value)
+	})
+}
+
+func (b LabelBuilder) Active(value bool) LabelBuilder {
+	return b.with(func(l *resource.Label) {
+		l.Active = Boolean(value)
+	})
+}
+
+func (b LabelBuilder) Position(value int) LabelBuilder {
+	return b.with(func(l *resource.Label) {
+		l.Postion = Integer(value)
+	})
+}
+
+// Types adds the kinds of objects the label applies to.
+func (b LabelBuilder) Types(values ...resource.LabelType) LabelBuilder {
+	return b.with(func(l *resource.Label) {
+		l.Types = append(l.Types, values...)
+	})
+}
+
+// Link adds a link named name to a resource of class at href.
+func (b LabelBuilder) Link(name string, class string, href string) LabelBuilder {
+	return b.with(func(l *resource.Label) {
+		l.AddHrefLinkWithClass(name, class, href)
+	})
+}
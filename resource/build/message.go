@@ -0,0 +1,115 @@
+package build
+
+import (
+	"github.com/wtlangford/go-desk/resource"
+	. "github.com/wtlangford/go-desk/types"
+)
+
+// MessageBuilder builds a *resource.Message.
+type MessageBuilder struct {
+	steps []func(*resource.Message)
+}
+
+// Message returns an empty MessageBuilder.
+func Message() MessageBuilder {
+	return MessageBuilder{}
+}
+
+func (b MessageBuilder) with(step func(*resource.Message)) MessageBuilder {
+	b.steps = append(b.steps[:len(b.steps):len(b.steps)], step)
+	return b
+}
+
+// Build returns a new message with every value set on the builder.
+func (b MessageBuilder) Build() *resource.Message {
+	m := resource.NewMessage()
+	for _, step := range b.steps {
+		step(m)
+	}
+	return m
+}
+
+func (b MessageBuilder) Direction(value resource.Direction) MessageBuilder {
+	return b.with(func(m *resource.Message) {
+		m.Direction = value.Ptr()
+	})
+}
+
+func (b MessageBuilder) Status(value resource.ReplyStatus) MessageBuilder {
+	return b.with(func(m *resource.Message) {
+		m.Status = value.Ptr()
+	})
+}
+
+func (b MessageBuilder) Subject(value string) MessageBuilder {
+	return b.with(func(m *resource.Message) {
+		m.Subject = String(value)
+	})
+}
+
+func (b MessageBuilder) Body(value string) MessageBuilder {
+	return b.with(func(m *resource.Message) {
+		m.Body = String(value)
+	})
+}
+
+func (b MessageBuilder) BodyText(value string) MessageBuilder {
+	return b.with(func(m *resource.Message) {
+		m.BodyText = String(value)
+	})
+}
+
+func (b MessageBuilder) BodyHtml(value string) MessageBuilder {
+	return b.with(func(m *resource.Message) {
+		m.BodyHtml = String(value)
+	})
+}
+
+func (b MessageBuilder) To(value string) MessageBuilder {
+	return b.with(func(m *resource.Message) {
+		m.To = String(value)
+	})
+}
+
+func (b MessageBuilder) From(value string) MessageBuilder {
+	return b.with(func(m *resource.Message) {
+		m.From = String(value)
+	})
+}
+
+func (b MessageBuilder) Cc(value string) MessageBuilder {
+	return b.with(func(m *resource.Message) {
+		m.Cc = String(value)
+	})
+}
+
+func (b MessageBuilder) Bcc(value string) MessageBuilder {
+	return b.with(func(m *resource.Message) {
+		m.Bcc = String(value)
+	})
+}
+
+func (b MessageBuilder) Headers(value string) MessageBuilder {
+	return b.with(func(m *resource.Message) {
+		m.Headers = String(value)
+	})
+}
+
+func (b MessageBuilder) HeadersRaw(value string) MessageBuilder {
+	return b.with(func(m *resource.Message) {
+		m.HeadersRaw = String(value)
+	})
+}
+
+func (b MessageBuilder) FromFacebookName(value string) MessageBuilder {
+	return b.with(func(m *resource.Message) {
+		m.FromFacebookName = String(value)
+	})
+}
+
+// Link adds a link named name to a resource of class at href.
+func (b MessageBuilder) Link(name string, class string, href string) MessageBuilder {
+	return b.with(func(m *resource.Message) {
+		m.AddHrefLinkWithClass(name, class, href)
+	})
+}
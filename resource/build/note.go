@@ -0,0 +1,49 @@
+package build
+
+import (
+	"github.com/wtlangford/go-desk/resource"
+	. "github.com/wtlangford/go-desk/types"
+)
+
+// NoteBuilder builds a *resource.Note.
+type NoteBuilder struct {
+	steps []func(*resource.Note)
+}
+
+// Note returns an empty NoteBuilder.
+func Note() NoteBuilder {
+	return NoteBuilder{}
+}
+
+func (b NoteBuilder) with(step func(*resource.Note)) NoteBuilder {
+	b.steps = append(b.steps[:len(b.steps):len(b.steps)], step)
+	return b
+}
+
+// Build returns a new note with every value set on the builder.
+func (b NoteBuilder) Build() *resource.Note {
+	n := resource.NewNote()
+	for _, step := range b.steps {
+		step(n)
+	}
+	return n
+}
+
+func (b NoteBuilder) Body(value string) NoteBuilder {
+	return b.with(func(n *resource.Note) {
+		n.Body = String(value)
+	})
+}
+
+func (b NoteBuilder) SuppressRules(value bool) NoteBuilder {
+	return b.with(func(n *resource.Note) {
+		n.SuppressRules = Boolean(value)
+	})
+}
+
+// Link adds a link named name to a resource of class at href.
+func (b NoteBuilder) Link(name string, class string, href string) NoteBuilder {
+	return b.with(func(n *resource.Note) {
+		n.AddHrefLinkWithClass(name, class, href)
+	})
+}
@@ -0,0 +1,121 @@
+package build
+
+import (
+	"github.com/wtlangford/go-desk/resource"
+	. "github.com/wtlangford/go-desk/types"
+)
+
+// ReplyBuilder builds a *resource.Reply.
+type ReplyBuilder struct {
+	steps []func(*resource.Reply)
+}
+
+// Reply returns an empty ReplyBuilder.
+func Reply() ReplyBuilder {
+	return ReplyBuilder{}
+}
+
+func (b ReplyBuilder) with(step func(*resource.Reply)) ReplyBuilder {
+	b.steps = append(b.steps[:len(b.steps):len(b.steps)], step)
+	return b
+}
+
+// Build returns a new reply with every value set on the builder.
+func (b ReplyBuilder) Build() *resource.Reply {
+	r := resource.NewReply()
+	for _, step := range b.steps {
+		step(r)
+	}
+	return r
+}
+
+func (b ReplyBuilder) Direction(value resource.Direction) ReplyBuilder {
+	return b.with(func(r *resource.Reply) {
+		r.Direction = value.Ptr()
+	})
+}
+
+func (b ReplyBuilder) Status(value resource.ReplyStatus) ReplyBuilder {
+	return b.with(func(r *resource.Reply) {
+		r.Status = value.Ptr()
+	})
+}
+
+func (b ReplyBuilder) Subject(value string) ReplyBuilder {
+	return b.with(func(r *resource.Reply) {
+		r.Subject = String(value)
+	})
+}
+
+func (b ReplyBuilder) Type(value string) ReplyBuilder {
+	return b.with(func(r *resource.Reply) {
+		r.Type = String(value)
+	})
+}
+
+func (b ReplyBuilder) Body(value string) ReplyBuilder {
+	return b.with(func(r *resource.Reply) {
+		r.Body = String(value)
+	})
+}
+
+func (b ReplyBuilder) BodyText(value string) ReplyBuilder {
+	return b.with(func(r *resource.Reply) {
+		r.BodyText = String(value)
+	})
+}
+
+func (b ReplyBuilder) BodyHtml(value string) ReplyBuilder {
+	return b.with(func(r *resource.Reply) {
+		r.BodyHtml = String(value)
+	})
+}
+
+func (b ReplyBuilder) To(value string) ReplyBuilder {
+	return b.with(func(r *resource.Reply) {
+		r.To = String(value)
+	})
+}
+
+func (b ReplyBuilder) From(value string) ReplyBuilder {
+	return b.with(func(r *resource.Reply) {
+		r.From = String(value)
+	})
+}
+
+func (b ReplyBuilder) Cc(value string) ReplyBuilder {
+	return b.with(func(r *resource.Reply) {
+		r.Cc = String(value)
+	})
+}
+
+func (b ReplyBuilder) Bcc(value string) ReplyBuilder {
+	return b.with(func(r *resource.Reply) {
+		r.Bcc = String(value)
+	})
+}
+
+func (b ReplyBuilder) Headers(value string) ReplyBuilder {
+	return b.with(func(r *resource.Reply) {
+		r.Headers = String(value)
+	})
+}
+
+func (b ReplyBuilder) HeadersRaw(value string) ReplyBuilder {
+	return b.with(func(r *resource.Reply) {
+		r.HeadersRaw = String(value)
+	})
+}
+
+func (b ReplyBuilder) FromFacebookName(value string) ReplyBuilder {
+	return b.with(func(r *resource.Reply) {
+		r.FromFacebookName = String(value)
+	})
+}
+
+// Link adds a link named name to a resource of class at href.
+func (b ReplyBuilder) Link(name string, class string, href string) ReplyBuilder {
+	return b.with(func(r *resource.Reply) {
+		r.AddHrefLinkWithClass(name, class, href)
+	})
+}
@@ -0,0 +1,73 @@
+package build
+
+import (
+	"github.com/wtlangford/go-desk/resource"
+	. "github.com/wtlangford/go-desk/types"
+)
+
+// UserBuilder builds a *resource.User.
+type UserBuilder struct {
+	steps []func(*resource.User)
+}
+
+// User returns an empty UserBuilder.
+func User() UserBuilder {
+	return UserBuilder{}
+}
+
+func (b UserBuilder) with(step func(*resource.User)) UserBuilder {
+	b.steps = append(b.steps[:len(b.steps):len(b.steps)], step)
+	return b
+}
+
+// Build returns a new user with every value set on the builder.
+func (b UserBuilder) Build() *resource.User {
+	u := resource.NewUser()
+	for _, step := range b.steps {
+		step(u)
+	}
+	return u
+}
+
+func (b UserBuilder) Name(value string) UserBuilder {
+	return b.with(func(u *resource.User) {
+		u.Name = String(value)
+	})
+}
+
+func (b UserBuilder) PublicName(value string) UserBuilder {
+	return b.with(func(u *resource.User) {
+		u.PublicName = String(value)
+	})
+}
+
+func (b UserBuilder) Email(value string) UserBuilder {
+	return b.with(func(u *resource.User) {
+		u.Email = String(value)
+	})
+}
+
+func (b UserBuilder) Available(value bool) UserBuilder {
+	return b.with(func(u *resource.User) {
+		u.Available = Boolean(value)
+	})
+}
+
+func (b UserBuilder) Avatar(value string) UserBuilder {
+	return b.with(func(u *resource.User) {
+		u.Avatar = String(value)
+	})
+}
+
+func (b UserBuilder) Level(value resource.UserLevel) UserBuilder {
+	return b.with(func(u *resource.User) {
+		u.Level = value.Ptr()
+	})
+}
+
+// Link adds a link named name to a resource of class at href.
+func (b UserBuilder) Link(name string, class string, href string) UserBuilder {
+	return b.with(func(u *resource.User) {
+		u.AddHrefLinkWithClass(name, class, href)
+	})
+}
@@ -4,30 +4,39 @@ import (
 	. "github.com/wtlangford/go-desk/types"
 )
 
+// Label actions control how Labels are applied when a case is updated. Desk
+// replaces the labels of a case unless told otherwise.
+const (
+	LabelActionAppend  = "append"
+	LabelActionRemove  = "remove"
+	LabelActionReplace = "replace"
+)
+
 type Case struct {
 	ExternalID      *string                `json:"external_id,omitempty"`
-	Type            *string                `json:"type,omitempty"`
-	Status          *string                `json:"status,omitempty"`
+	Type            *CaseType              `json:"type,omitempty" desk:"createonly"`
+	Status          *CaseStatus            `json:"status,omitempty"`
 	Description     *string                `json:"description,omitempty"`
 	Subject         *string                `json:"subject,omitempty"`
-	Blurb           *string                `json:"blurb,omitempty"`
+	Blurb           *string                `json:"blurb,omitempty" desk:"readonly"`
 	Language        *string                `json:"language,omitempty"`
 	Priority        *int                   `json:"priority,omitempty"`
 	Labels          []string               `json:"labels,omitempty"`
 	LabelIDs        []int                  `json:"label_ids,omitempty"`
+	LabelAction     *string                `json:"label_action,omitempty"`
 	SuppressRules   *bool                  `json:"suppress_rules,omitempty"`
 	CustomFields    map[string]interface{} `json:"custom_fields,omitempty"`
-	LockedUntil     *Timestamp             `json:"locked_until",omitempty`
-	CreatedAt       *Timestamp             `json:"created_at,omitempty"`
-	UpdatedAt       *Timestamp             `json:"updated_at,omitempty"`
-	ChangedAt       *Timestamp             `json:"changed_at,omitempty"`
-	ReceivedAt      *Timestamp             `json:"received_at,omitempty"`
-	ActiveAt        *Timestamp             `json:"active_at,omitempty"`
-	OpenedAt        *Timestamp             `json:"opened_at,omitempty"`
-	FirstOpenedAt   *Timestamp             `json:"first_opened_at,omitempty"`
-	ResolvedAt      *Timestamp             `json:"resolved_at,omitempty"`
-	FirstResolvedAt *Timestamp             `json:"first_resolved_at,omitempty"`
-	Message         *Message               `json:"message,omitempty"`
+	LockedUntil     *Timestamp             `json:"locked_until,omitempty"`
+	CreatedAt       *Timestamp             `json:"created_at,omitempty" desk:"readonly"`
+	UpdatedAt       *Timestamp             `json:"updated_at,omitempty" desk:"readonly"`
+	ChangedAt       *Timestamp             `json:"changed_at,omitempty" desk:"readonly"`
+	ReceivedAt      *Timestamp             `json:"received_at,omitempty" desk:"readonly"`
+	ActiveAt        *Timestamp             `json:"active_at,omitempty" desk:"readonly"`
+	OpenedAt        *Timestamp             `json:"opened_at,omitempty" desk:"readonly"`
+	FirstOpenedAt   *Timestamp             `json:"first_opened_at,omitempty" desk:"readonly"`
+	ResolvedAt      *Timestamp             `json:"resolved_at,omitempty" desk:"readonly"`
+	FirstResolvedAt *Timestamp             `json:"first_resolved_at,omitempty" desk:"readonly"`
+	Message         *Message               `json:"message,omitempty" desk:"createonly"`
 	Resource
 }
 
@@ -40,3 +49,43 @@ func NewCase() *Case {
 func (c Case) String() string {
 	return Stringify(c)
 }
+
+// Validate checks the case before it is written in op. A new case needs a
+// message and a customer link.
+func (c *Case) Validate(op Operation) error {
+	errs := make(ValidationErrors)
+	errs.checkEnums(c, op)
+	errs.requireNested("message", c.Message, c.Message == nil, op)
+	if op == OperationCreate && !c.HasLink("customer") {
+		errs.Add("_links.customer", ValidationBlank)
+	}
+	if c.Priority != nil && (*c.Priority < 1 || *c.Priority > 10) {
+		errs.Add("priority", ValidationInclusion)
+	}
+	return errs.Err()
+}
+
+// SetCustomer links the case to a customer.
+func (c *Case) SetCustomer(customer *Customer) {
+	c.AddHrefLinkWithClass("customer", "customer", customer.GetResourcePath(customer).Href())
+}
+
+// SetAssignedUser assigns the case to a user.
+func (c *Case) SetAssignedUser(user *User) {
+	c.AddHrefLinkWithClass("assigned_user", "user", user.GetResourcePath(user).Href())
+}
+
+// SetAssignedGroup assigns the case to a group.
+func (c *Case) SetAssignedGroup(group *Group) {
+	c.AddHrefLinkWithClass("assigned_group", "group", group.GetResourcePath(group).Href())
+}
+
+// ClearAssignedUser unassigns the case from its user.
+func (c *Case) ClearAssignedUser() {
+	c.UnsetLink("assigned_user")
+}
+
+// ClearAssignedGroup unassigns the case from its group.
+func (c *Case) ClearAssignedGroup() {
+	c.UnsetLink("assigned_group")
+}
@@ -5,15 +5,16 @@ import (
 )
 
 type CaseEvent struct {
-	Type      *string                  `json:"type,omitempty"`
-	Context   *string                  `json:"context,omitempty"`
-	CreatedAt *Timestamp               `json:"created_at,omitempty"`
-	Changes   []map[string]interface{} `json:"changes,omitempty"`
+	Type      *string                  `json:"type,omitempty" desk:"readonly"`
+	Context   *string                  `json:"context,omitempty" desk:"readonly"`
+	CreatedAt *Timestamp               `json:"created_at,omitempty" desk:"readonly"`
+	Changes   []map[string]interface{} `json:"changes,omitempty" desk:"readonly"`
 	Resource
 }
 
 func NewCaseEvent() *CaseEvent {
 	case_event := &CaseEvent{}
+	case_event.InitializeResource(case_event)
 	return case_event
 }
 
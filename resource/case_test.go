@@ -0,0 +1,41 @@
+package resource
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestCase(t *testing.T) {
+	fmt.Println("")
+	Convey("SetCustomer", t, func() {
+		Convey("should link the customer by href", func() {
+			customer := NewCustomer()
+			customer.SetResourceId("192220782")
+			caze := NewCase()
+			caze.SetCustomer(customer)
+			So(caze.GetHrefLink("customer"), ShouldEqual, "/api/v2/customers/192220782")
+			So(caze.GetLinkSubItemStringValue("customer", "class"), ShouldEqual, "customer")
+		})
+	})
+	Convey("SetAssignedUser", t, func() {
+		Convey("should link the user as assigned_user", func() {
+			user := NewUser()
+			user.SetResourceId("7")
+			caze := NewCase()
+			caze.SetAssignedUser(user)
+			So(caze.GetHrefLink("assigned_user"), ShouldEqual, "/api/v2/users/7")
+			So(caze.GetLinkSubItemStringValue("assigned_user", "class"), ShouldEqual, "user")
+		})
+	})
+	Convey("SetAssignedGroup", t, func() {
+		Convey("should link the group as assigned_group", func() {
+			group := NewGroup()
+			group.SetResourceId("3")
+			caze := NewCase()
+			caze.SetAssignedGroup(group)
+			So(caze.GetHrefLink("assigned_group"), ShouldEqual, "/api/v2/groups/3")
+			So(caze.GetLinkSubItemStringValue("assigned_group", "class"), ShouldEqual, "group")
+		})
+	})
+}
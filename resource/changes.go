@@ -0,0 +1,122 @@
+package resource
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// Tracked is implemented by resources that remember the state they were
+// decoded in, so that updates can send only the fields that changed.
+type Tracked interface {
+	TakeSnapshot(model interface{}) error
+	HasSnapshot() bool
+	Diff(model interface{}) (map[string]interface{}, error)
+}
+
+// TakeSnapshot records the encoded state of model, the resource embedding r.
+// The client takes a snapshot of every resource it decodes, and of every
+// resource once it was updated.
+func (r *Resource) TakeSnapshot(model interface{}) error {
+	fields, err := encodeFields(model)
+	if err != nil {
+		return err
+	}
+	r.snapshot = fields
+	return nil
+}
+
+// HasSnapshot reports whether a snapshot was taken of the resource.
+func (r *Resource) HasSnapshot() bool {
+	return r.snapshot != nil
+}
+
+// ClearSnapshot forgets the snapshot, so the resource is sent whole again.
+func (r *Resource) ClearSnapshot() {
+	r.snapshot = nil
+}
+
+// Diff returns the encoded fields of model, the resource embedding r, that
+// differ from its snapshot. Fields holding objects, such as custom_fields and
+// _links, are compared key by key and only the changed keys are returned.
+// Fields cleared since the snapshot are not part of the diff. Without a
+// snapshot every field is returned.
+func (r *Resource) Diff(model interface{}) (map[string]interface{}, error) {
+	current, err := encodeFields(model)
+	if err != nil {
+		return nil, err
+	}
+	if r.snapshot == nil {
+		return current, nil
+	}
+	diff := make(map[string]interface{})
+	for k, v := range current {
+		old, ok := r.snapshot[k]
+		if !ok {
+			diff[k] = v
+			continue
+		}
+		if reflect.DeepEqual(old, v) {
+			continue
+		}
+		oldObject, oldIsObject := old.(map[string]interface{})
+		object, isObject := v.(map[string]interface{})
+		if !oldIsObject || !isObject {
+			diff[k] = v
+			continue
+		}
+		changed := make(map[string]interface{})
+		for name, value := range object {
+			if !reflect.DeepEqual(oldObject[name], value) {
+				changed[name] = value
+			}
+		}
+		if len(changed) > 0 {
+			diff[k] = changed
+		}
+	}
+	return diff, nil
+}
+
+// forceDiff makes field part of the next diff even if it did not change, e.g.
+// a list sent with an update action.
+func (r *Resource) forceDiff(field string) {
+	delete(r.snapshot, field)
+}
+
+// copyTracking copies from src the state r keeps besides the encoded fields:
+// the snapshot, the null marks and which extras were set with SetExtra.
+func (r *Resource) copyTracking(src *Resource) {
+	r.snapshot = nil
+	if src.snapshot != nil {
+		r.snapshot = make(map[string]interface{}, len(src.snapshot))
+		for k, v := range src.snapshot {
+			r.snapshot[k] = v
+		}
+	}
+	r.nulls = nil
+	for field := range src.nulls {
+		r.SetNull(field)
+	}
+	r.writableExtras = nil
+	for name := range src.writableExtras {
+		if r.writableExtras == nil {
+			r.writableExtras = make(map[string]bool)
+		}
+		r.writableExtras[name] = true
+	}
+}
+
+// encodeFields encodes model the way it is sent to Desk and decodes it back
+// into a map, so values compare the same regardless of their Go types.
+func encodeFields(model interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(model)
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]interface{})
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
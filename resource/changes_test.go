@@ -0,0 +1,104 @@
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	. "github.com/wtlangford/go-desk/types"
+	"testing"
+)
+
+func TestChanges(t *testing.T) {
+	fmt.Println("")
+	data := `{"subject":"Help","status":"open","created_at":"2015-01-02T03:04:05Z",
+		"custom_fields":{"level":"gold","region":"emea"},
+		"_links":{"self":{"href":"/api/v2/cases/1","class":"case"},
+		"customer":{"href":"/api/v2/customers/2","class":"customer"}}}`
+	decode := func() *Case {
+		cse := NewCase()
+		json.Unmarshal([]byte(data), cse)
+		cse.TakeSnapshot(cse)
+		return cse
+	}
+	Convey("Diff", t, func() {
+		Convey("should be empty for an unchanged resource", func() {
+			cse := decode()
+			So(cse.HasSnapshot(), ShouldBeTrue)
+			diff, err := cse.Diff(cse)
+			So(err, ShouldBeNil)
+			So(diff, ShouldBeEmpty)
+		})
+		Convey("should contain changed fields only", func() {
+			cse := decode()
+			cse.Status = CaseStatusResolved.Ptr()
+			cse.Priority = Integer(8)
+			diff, _ := cse.Diff(cse)
+			So(diff, ShouldResemble, map[string]interface{}{"status": "resolved", "priority": float64(8)})
+		})
+		Convey("should contain changed keys of objects", func() {
+			cse := decode()
+			cse.CustomFields["level"] = "platinum"
+			user := NewUser()
+			user.SetResourceId("3")
+			cse.SetAssignedUser(user)
+			diff, _ := cse.Diff(cse)
+			So(diff["custom_fields"], ShouldResemble, map[string]interface{}{"level": "platinum"})
+			links := diff["_links"].(map[string]interface{})
+			So(len(links), ShouldEqual, 1)
+			So(links["assigned_user"], ShouldNotBeNil)
+		})
+		Convey("should contain every field without a snapshot", func() {
+			cse := NewCase()
+			cse.Subject = String("New")
+			So(cse.HasSnapshot(), ShouldBeFalse)
+			diff, _ := cse.Diff(cse)
+			So(diff, ShouldResemble, map[string]interface{}{"subject": "New"})
+		})
+		Convey("should contain every field after clearing the snapshot", func() {
+			cse := decode()
+			cse.ClearSnapshot()
+			diff, _ := cse.Diff(cse)
+			So(diff["created_at"], ShouldEqual, "2015-01-02T03:04:05Z")
+		})
+	})
+	Convey("DecodeResourceAs", t, func() {
+		Convey("should take a snapshot", func() {
+			res, _ := DecodeResourceAs("case", []byte(data))
+			So(res.(*Case).HasSnapshot(), ShouldBeTrue)
+		})
+	})
+	Convey("Draft.Copy", t, func() {
+		original := NewDraft()
+		json.Unmarshal([]byte(`{"body":"hello","mood":"happy",
+			"_links":{"self":{"href":"/api/v2/cases/1/replies/5","class":"reply"}}}`), original)
+		original.TakeSnapshot(original)
+		original.SetExtra("nickname", "Ada")
+		original.SetNull("subject")
+		Convey("should not share links, extras or change tracking", func() {
+			copied, err := original.Copy()
+			So(err, ShouldBeNil)
+			copied.Body = String("changed")
+			copied.AddHrefLink("outbound_mailbox", "/api/v2/mailboxes/outbound/1")
+			copied.SetExtra("nickname", "Bob")
+			copied.ClearNull("subject")
+			copied.TakeSnapshot(copied)
+			So(*original.Body, ShouldEqual, "hello")
+			So(original.HasLink("outbound_mailbox"), ShouldBeFalse)
+			nickname, _ := original.Extra("nickname")
+			So(nickname, ShouldEqual, "Ada")
+			So(original.IsNull("subject"), ShouldBeTrue)
+			diff, _ := original.Diff(original)
+			So(diff["nickname"], ShouldNotBeNil)
+			So(diff["body"], ShouldBeNil)
+		})
+		Convey("should write the same fields as the original", func() {
+			copied, _ := original.Copy()
+			data, _ := copied.MarshalForWrite(copied, OperationCreate)
+			expected, _ := original.MarshalForWrite(original, OperationCreate)
+			So(string(data), ShouldEqual, string(expected))
+			diff, _ := copied.Diff(copied)
+			So(diff["nickname"], ShouldNotBeNil)
+			So(diff["body"], ShouldBeNil)
+		})
+	})
+}
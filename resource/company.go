@@ -5,12 +5,15 @@ import (
 )
 
 type Company struct {
-	ExternalID   *string                `json:"external_id,omitempty"`
-	Name         *string                `json:"name,omitempty"`
-	Domains      []string               `json:"domains,omitempty"`
-	CreatedAt    *Timestamp             `json:"created_at,omitempty"`
-	UpdatedAt    *Timestamp             `json:"updated_at,omitempty"`
-	CustomFields map[string]interface{} `json:"custom_fields,omitempty"`
+	ExternalID *string  `json:"external_id,omitempty"`
+	Name       *string  `json:"name,omitempty"`
+	Domains    []string `json:"domains,omitempty"`
+	// DomainsUpdateAction tells Desk how to apply Domains on update, see
+	// UpdateDomains. It is not sent on create.
+	DomainsUpdateAction *UpdateAction          `json:"domains_update_action,omitempty" desk:"action=domains"`
+	CreatedAt           *Timestamp             `json:"created_at,omitempty" desk:"readonly"`
+	UpdatedAt           *Timestamp             `json:"updated_at,omitempty" desk:"readonly"`
+	CustomFields        map[string]interface{} `json:"custom_fields,omitempty"`
 	Resource
 }
 
@@ -24,6 +27,35 @@ func (c Company) String() string {
 	return Stringify(c)
 }
 
+// Validate checks the company before it is written in op. A company needs a
+// name.
+func (c *Company) Validate(op Operation) error {
+	errs := make(ValidationErrors)
+	errs.requireString("name", c.Name, op)
+	return errs.Err()
+}
+
 func (c *Company) AddDomain(domain string) {
 	c.Domains = append(c.Domains, domain)
 }
+
+// HasDomain reports whether domain is one of the domains of the company.
+func (c *Company) HasDomain(domain string) bool {
+	for _, d := range c.Domains {
+		if d == domain {
+			return true
+		}
+	}
+	return false
+}
+
+// UpdateDomains sets the domains sent on the next update and how Desk applies
+// them: UpdateAppend adds them to the domains of the company, UpdateRemove
+// removes them and UpdateReplace replaces every domain, so replacing with no
+// domains clears them. The action is cleared once CompanyService.Update
+// succeeds.
+func (c *Company) UpdateDomains(action UpdateAction, domains ...string) {
+	c.Domains = domains
+	c.DomainsUpdateAction = action.Ptr()
+	c.forceDiff("domains")
+}
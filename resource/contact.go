@@ -0,0 +1,64 @@
+package resource
+
+// ContactType is the kind of a customer email address, phone number or postal
+// address. Desk accepts other types too, e.g. "fax" for phone numbers.
+type ContactType string
+
+const (
+	ContactWork   ContactType = "work"
+	ContactHome   ContactType = "home"
+	ContactMobile ContactType = "mobile"
+	ContactOther  ContactType = "other"
+)
+
+// ContactPoint is an email address, phone number or postal address of a
+// customer.
+type ContactPoint struct {
+	Type  ContactType `json:"type,omitempty"`
+	Value string      `json:"value"`
+}
+
+// ContactPoints is a list of contact points of the same kind, such as the
+// email addresses of a customer.
+type ContactPoints []ContactPoint
+
+// OfType returns the contact points of type t.
+func (c ContactPoints) OfType(t ContactType) ContactPoints {
+	var found ContactPoints
+	for _, point := range c {
+		if point.Type == t {
+			found = append(found, point)
+		}
+	}
+	return found
+}
+
+// First returns the value of the first contact point of type t, or false if
+// there is none.
+func (c ContactPoints) First(t ContactType) (string, bool) {
+	for _, point := range c {
+		if point.Type == t {
+			return point.Value, true
+		}
+	}
+	return "", false
+}
+
+// Contains reports whether a contact point has value.
+func (c ContactPoints) Contains(value string) bool {
+	for _, point := range c {
+		if point.Value == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Values returns the values of the contact points in order.
+func (c ContactPoints) Values() []string {
+	values := make([]string, len(c))
+	for i, point := range c {
+		values[i] = point.Value
+	}
+	return values
+}
@@ -10,16 +10,22 @@ type Customer struct {
 	LastName     *string                `json:"last_name,omitempty"`
 	Company      *string                `json:"company,omitempty"`
 	Title        *string                `json:"title,omitempty"`
-	Avatar       *string                `json:"avatar,omitempty"`
+	Avatar       *string                `json:"avatar,omitempty" desk:"readonly"`
 	Background   *string                `json:"background,omitempty"`
 	Language     *string                `json:"language,omitempty"`
 	LockedUntil  *Timestamp             `json:"locked_until,omitempty"`
-	CreatedAt    *Timestamp             `json:"created_at,omitempty"`
-	UpdatedAt    *Timestamp             `json:"updated_at,omitempty"`
+	CreatedAt    *Timestamp             `json:"created_at,omitempty" desk:"readonly"`
+	UpdatedAt    *Timestamp             `json:"updated_at,omitempty" desk:"readonly"`
 	CustomFields map[string]interface{} `json:"custom_fields,omitempty"`
-	Emails       []map[string]string    `json:"emails,omitempty"`
-	PhoneNumbers []map[string]string    `json:"phone_numbers,omitempty"`
-	Addresses    []map[string]string    `json:"addresses,omitempty"`
+	Emails       ContactPoints          `json:"emails,omitempty"`
+	PhoneNumbers ContactPoints          `json:"phone_numbers,omitempty"`
+	Addresses    ContactPoints          `json:"addresses,omitempty"`
+	// The update actions tell Desk how to apply the lists above on update,
+	// see UpdateEmails. Without one Desk applies its default. They are not
+	// sent on create.
+	EmailsUpdateAction       *UpdateAction `json:"emails_update_action,omitempty" desk:"action=emails"`
+	PhoneNumbersUpdateAction *UpdateAction `json:"phone_numbers_update_action,omitempty" desk:"action=phone_numbers"`
+	AddressesUpdateAction    *UpdateAction `json:"addresses_update_action,omitempty" desk:"action=addresses"`
 	Resource
 }
 
@@ -33,27 +39,59 @@ func (c Customer) String() string {
 	return Stringify(c)
 }
 
-func (c *Customer) AddEmail(email string, emailType string) {
-	c.Emails = c.AddToSlice(c.Emails, email, emailType)
+// Validate checks the customer before it is written in op. A new customer
+// needs a name, an email address or a phone number.
+func (c *Customer) Validate(op Operation) error {
+	errs := make(ValidationErrors)
+	if op == OperationCreate && c.FirstName == nil && c.LastName == nil && len(c.Emails) == 0 && len(c.PhoneNumbers) == 0 {
+		errs.Add("first_name", ValidationBlank)
+	}
+	return errs.Err()
+}
+
+func (c *Customer) AddEmail(email string, emailType ContactType) {
+	c.Emails = append(c.Emails, ContactPoint{Type: emailType, Value: email})
 }
 
-func (c *Customer) AddAddress(address string, addressType string) {
-	c.Addresses = c.AddToSlice(c.Addresses, address, addressType)
+func (c *Customer) AddAddress(address string, addressType ContactType) {
+	c.Addresses = append(c.Addresses, ContactPoint{Type: addressType, Value: address})
 }
 
-func (c *Customer) AddPhoneNumber(phone string, phoneType string) {
-	c.PhoneNumbers = c.AddToSlice(c.PhoneNumbers, phone, phoneType)
+func (c *Customer) AddPhoneNumber(phone string, phoneType ContactType) {
+	c.PhoneNumbers = append(c.PhoneNumbers, ContactPoint{Type: phoneType, Value: phone})
 }
 
+// AddToSlice appends a value and its type to a list of contact maps.
+//
+// Deprecated: Emails, PhoneNumbers and Addresses hold ContactPoints; use
+// AddEmail, AddPhoneNumber and AddAddress instead.
 func (c *Customer) AddToSlice(slice []map[string]string, value string, valueType string) []map[string]string {
-	pair := make(map[string]string)
-	pair["value"] = value
-	pair["type"] = valueType
-	if slice == nil {
-		slice = make([]map[string]string, 1, 2)
-		slice[0] = pair
-	} else {
-		slice = append(slice, pair)
-	}
-	return slice
+	return append(slice, map[string]string{"value": value, "type": valueType})
+}
+
+// UpdateEmails sets the email addresses sent on the next update and how Desk
+// applies them: UpdateAppend adds them to the addresses of the customer,
+// UpdateRemove removes them and UpdateReplace replaces every address, so
+// replacing with no addresses clears them. The action is cleared once
+// CustomerService.Update succeeds.
+func (c *Customer) UpdateEmails(action UpdateAction, emails ...ContactPoint) {
+	c.Emails = emails
+	c.EmailsUpdateAction = action.Ptr()
+	c.forceDiff("emails")
+}
+
+// UpdatePhoneNumbers sets the phone numbers sent on the next update and how
+// Desk applies them, see UpdateEmails.
+func (c *Customer) UpdatePhoneNumbers(action UpdateAction, phones ...ContactPoint) {
+	c.PhoneNumbers = phones
+	c.PhoneNumbersUpdateAction = action.Ptr()
+	c.forceDiff("phone_numbers")
+}
+
+// UpdateAddresses sets the postal addresses sent on the next update and how
+// Desk applies them, see UpdateEmails.
+func (c *Customer) UpdateAddresses(action UpdateAction, addresses ...ContactPoint) {
+	c.Addresses = addresses
+	c.AddressesUpdateAction = action.Ptr()
+	c.forceDiff("addresses")
 }
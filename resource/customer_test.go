@@ -1,6 +1,7 @@
 package resource
 
 import (
+	"encoding/json"
 	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
 	"testing"
@@ -14,8 +15,8 @@ func TestCustomer(t *testing.T) {
 				customer := Customer{}
 				customer.AddAddress("val1", "val1Type")
 				So(customer.Addresses[0], ShouldNotBeNil)
-				So(customer.Addresses[0]["type"], ShouldEqual, "val1Type")
-				So(customer.Addresses[0]["value"], ShouldEqual, "val1")
+				So(customer.Addresses[0].Type, ShouldEqual, ContactType("val1Type"))
+				So(customer.Addresses[0].Value, ShouldEqual, "val1")
 			})
 		})
 		Convey("when another address exists", func() {
@@ -24,8 +25,8 @@ func TestCustomer(t *testing.T) {
 				customer.AddEmail("me@me.com", "work")
 				customer.AddEmail("me2@me.com", "home")
 				So(customer.Emails[1], ShouldNotBeNil)
-				So(customer.Emails[1]["type"], ShouldEqual, "home")
-				So(customer.Emails[1]["value"], ShouldEqual, "me2@me.com")
+				So(customer.Emails[1].Type, ShouldEqual, ContactHome)
+				So(customer.Emails[1].Value, ShouldEqual, "me2@me.com")
 			})
 		})
 	})
@@ -35,8 +36,8 @@ func TestCustomer(t *testing.T) {
 				customer := Customer{}
 				customer.AddEmail("me@me.com", "work")
 				So(customer.Emails[0], ShouldNotBeNil)
-				So(customer.Emails[0]["type"], ShouldEqual, "work")
-				So(customer.Emails[0]["value"], ShouldEqual, "me@me.com")
+				So(customer.Emails[0].Type, ShouldEqual, ContactWork)
+				So(customer.Emails[0].Value, ShouldEqual, "me@me.com")
 			})
 		})
 		Convey("when another email exists", func() {
@@ -45,8 +46,8 @@ func TestCustomer(t *testing.T) {
 				customer.AddEmail("me@me.com", "work")
 				customer.AddEmail("me2@me.com", "home")
 				So(customer.Emails[1], ShouldNotBeNil)
-				So(customer.Emails[1]["type"], ShouldEqual, "home")
-				So(customer.Emails[1]["value"], ShouldEqual, "me2@me.com")
+				So(customer.Emails[1].Type, ShouldEqual, ContactHome)
+				So(customer.Emails[1].Value, ShouldEqual, "me2@me.com")
 			})
 		})
 	})
@@ -56,8 +57,8 @@ func TestCustomer(t *testing.T) {
 				customer := Customer{}
 				customer.AddPhoneNumber("val1", "type1")
 				So(customer.PhoneNumbers[0], ShouldNotBeNil)
-				So(customer.PhoneNumbers[0]["type"], ShouldEqual, "type1")
-				So(customer.PhoneNumbers[0]["value"], ShouldEqual, "val1")
+				So(customer.PhoneNumbers[0].Type, ShouldEqual, ContactType("type1"))
+				So(customer.PhoneNumbers[0].Value, ShouldEqual, "val1")
 			})
 		})
 		Convey("when another phone number exists", func() {
@@ -66,8 +67,49 @@ func TestCustomer(t *testing.T) {
 				customer.AddPhoneNumber("val1", "type1")
 				customer.AddPhoneNumber("val2", "type2")
 				So(customer.PhoneNumbers[1], ShouldNotBeNil)
-				So(customer.PhoneNumbers[1]["type"], ShouldEqual, "type2")
-				So(customer.PhoneNumbers[1]["value"], ShouldEqual, "val2")
+				So(customer.PhoneNumbers[1].Type, ShouldEqual, ContactType("type2"))
+				So(customer.PhoneNumbers[1].Value, ShouldEqual, "val2")
+			})
+		})
+	})
+	Convey("ContactPoints", t, func() {
+		emails := ContactPoints{
+			{Type: ContactWork, Value: "ada@work.com"},
+			{Type: ContactHome, Value: "ada@home.com"},
+			{Type: ContactWork, Value: "ada@lab.com"},
+		}
+		Convey("should find contact points by type", func() {
+			So(emails.OfType(ContactWork).Values(), ShouldResemble, []string{"ada@work.com", "ada@lab.com"})
+			So(emails.OfType(ContactMobile), ShouldBeEmpty)
+			value, ok := emails.First(ContactHome)
+			So(ok, ShouldBeTrue)
+			So(value, ShouldEqual, "ada@home.com")
+			_, ok = emails.First(ContactOther)
+			So(ok, ShouldBeFalse)
+		})
+		Convey("should find contact points by value", func() {
+			So(emails.Contains("ada@lab.com"), ShouldBeTrue)
+			So(emails.Contains("bob@lab.com"), ShouldBeFalse)
+		})
+		Convey("should decode from Desk", func() {
+			customer := NewCustomer()
+			err := json.Unmarshal([]byte(`{"emails":[{"type":"work","value":"ada@work.com"}],"phone_numbers":[{"type":"fax","value":"555-0100"}]}`), customer)
+			So(err, ShouldBeNil)
+			So(customer.Emails, ShouldResemble, ContactPoints{{Type: ContactWork, Value: "ada@work.com"}})
+			So(customer.PhoneNumbers[0].Type, ShouldEqual, ContactType("fax"))
+		})
+	})
+	Convey("UpdateEmails", t, func() {
+		Convey("should send the emails with the action even when unchanged", func() {
+			customer := NewCustomer()
+			json.Unmarshal([]byte(`{"first_name":"Ada","emails":[{"type":"work","value":"ada@work.com"}]}`), customer)
+			customer.TakeSnapshot(customer)
+			customer.UpdateEmails(UpdateRemove, ContactPoint{Type: ContactWork, Value: "ada@work.com"})
+			diff, err := customer.Diff(customer)
+			So(err, ShouldBeNil)
+			So(diff, ShouldResemble, map[string]interface{}{
+				"emails":               []interface{}{map[string]interface{}{"type": "work", "value": "ada@work.com"}},
+				"emails_update_action": "remove",
 			})
 		})
 	})
@@ -1,34 +1,35 @@
 package resource
 
 import (
+	"encoding/json"
 	. "github.com/wtlangford/go-desk/types"
 )
 
 type Draft struct {
-	Direction        *string    `json:"direction,omitempty"`
-	Body             *string    `json:"body,omitempty"`
-	BodyText         *string    `json:"body_text,omitempty"`
-	BodyHtml         *string    `json:"body_html,omitempty"`
-	Headers          *string    `json:"headers,omitempty"`
-	HeadersRaw       *string    `json:"headers_raw,omitempty"`
-	Status           *string    `json:"status,omitempty"`
-	Subject          *string    `json:"subject,omitempty"`
-	To               *string    `json:"to,omitempty"`
-	From             *string    `json:"from,omitempty"`
-	Type             *string    `json:"type,omitempty"`
-	Cc               *string    `json:"cc,omitempty"`
-	Bcc              *string    `json:"bcc,omitempty"`
-	ClientType       *string    `json:"client_type,omitempty"`
-	FromFacebookName *string    `json:"from_facebook_name,omitempty"`
-	PublicUrl        *string    `json:"public_url,omitempty"`
-	IsBestAnswer     *string    `json:"is_best_answer,omitempty"`
-	Rating           *float32   `json:"rating,omitempty"`
-	RatingCount      *int       `json:"rating_count,omitempty"`
-	RatingScore      *int       `json:"rating_score,omitempty"`
-	EnteredAt        *Timestamp `json:"entered_at,omitempty"`
-	HiddentAt        *Timestamp `json:"hidden_at,omitempty"`
-	CreatedAt        *Timestamp `json:"created_at,omitempty"`
-	UpdatedAt        *Timestamp `json:"updated_at,omitempty"`
+	Direction        *Direction   `json:"direction,omitempty" desk:"createonly"`
+	Body             *string      `json:"body,omitempty"`
+	BodyText         *string      `json:"body_text,omitempty"`
+	BodyHtml         *string      `json:"body_html,omitempty"`
+	Headers          *string      `json:"headers,omitempty"`
+	HeadersRaw       *string      `json:"headers_raw,omitempty"`
+	Status           *ReplyStatus `json:"status,omitempty"`
+	Subject          *string      `json:"subject,omitempty"`
+	To               *string      `json:"to,omitempty"`
+	From             *string      `json:"from,omitempty"`
+	Type             *string      `json:"type,omitempty"`
+	Cc               *string      `json:"cc,omitempty"`
+	Bcc              *string      `json:"bcc,omitempty"`
+	ClientType       *string      `json:"client_type,omitempty" desk:"readonly"`
+	FromFacebookName *string      `json:"from_facebook_name,omitempty"`
+	PublicUrl        *string      `json:"public_url,omitempty" desk:"readonly"`
+	IsBestAnswer     *string      `json:"is_best_answer,omitempty" desk:"readonly"`
+	Rating           *float32     `json:"rating,omitempty" desk:"readonly"`
+	RatingCount      *int         `json:"rating_count,omitempty" desk:"readonly"`
+	RatingScore      *int         `json:"rating_score,omitempty" desk:"readonly"`
+	EnteredAt        *Timestamp   `json:"entered_at,omitempty" desk:"readonly"`
+	HiddentAt        *Timestamp   `json:"hidden_at,omitempty" desk:"readonly"`
+	CreatedAt        *Timestamp   `json:"created_at,omitempty" desk:"readonly"`
+	UpdatedAt        *Timestamp   `json:"updated_at,omitempty" desk:"readonly"`
 	Resource
 }
 
@@ -39,6 +40,31 @@ func NewDraft() *Draft {
 	return d
 }
 
+// Copy returns a deep copy of the draft, links, extras and change tracking
+// included, that can be changed without affecting c.
+func (c *Draft) Copy() (*Draft, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	copied := NewDraft()
+	err = json.Unmarshal(data, copied)
+	if err != nil {
+		return nil, err
+	}
+	copied.copyTracking(&c.Resource)
+	return copied, nil
+}
+
+// Validate checks the draft before it is written in op. A new draft needs a
+// body.
+func (c *Draft) Validate(op Operation) error {
+	errs := make(ValidationErrors)
+	errs.checkEnums(c, op)
+	errs.requireString("body", c.Body, op)
+	return errs.Err()
+}
+
 func (c Draft) String() string {
 	return Stringify(c)
 }
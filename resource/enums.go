@@ -0,0 +1,234 @@
+package resource
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// CaseStatus is the status of a case.
+type CaseStatus string
+
+const (
+	CaseStatusNew      CaseStatus = "new"
+	CaseStatusOpen     CaseStatus = "open"
+	CaseStatusPending  CaseStatus = "pending"
+	CaseStatusResolved CaseStatus = "resolved"
+	CaseStatusClosed   CaseStatus = "closed"
+)
+
+func (s CaseStatus) Ptr() *CaseStatus {
+	return &s
+}
+
+func (s CaseStatus) IsKnown() bool {
+	switch s {
+	case CaseStatusNew, CaseStatusOpen, CaseStatusPending, CaseStatusResolved, CaseStatusClosed:
+		return true
+	}
+	return false
+}
+
+// CaseType is the channel a case came in through.
+type CaseType string
+
+const (
+	CaseTypeEmail    CaseType = "email"
+	CaseTypeTwitter  CaseType = "twitter"
+	CaseTypeFacebook CaseType = "facebook"
+	CaseTypeChat     CaseType = "chat"
+	CaseTypePhone    CaseType = "phone"
+	CaseTypeQna      CaseType = "qna"
+)
+
+func (t CaseType) Ptr() *CaseType {
+	return &t
+}
+
+func (t CaseType) IsKnown() bool {
+	switch t {
+	case CaseTypeEmail, CaseTypeTwitter, CaseTypeFacebook, CaseTypeChat, CaseTypePhone, CaseTypeQna:
+		return true
+	}
+	return false
+}
+
+// Direction tells whether a message or reply was received from or sent to a
+// customer.
+type Direction string
+
+const (
+	DirectionIn  Direction = "in"
+	DirectionOut Direction = "out"
+)
+
+func (d Direction) Ptr() *Direction {
+	return &d
+}
+
+func (d Direction) IsKnown() bool {
+	return d == DirectionIn || d == DirectionOut
+}
+
+// ReplyStatus is the delivery status of a message, reply or draft.
+type ReplyStatus string
+
+const (
+	ReplyStatusDraft    ReplyStatus = "draft"
+	ReplyStatusPending  ReplyStatus = "pending"
+	ReplyStatusSent     ReplyStatus = "sent"
+	ReplyStatusReceived ReplyStatus = "received"
+	ReplyStatusFailed   ReplyStatus = "failed"
+)
+
+func (s ReplyStatus) Ptr() *ReplyStatus {
+	return &s
+}
+
+func (s ReplyStatus) IsKnown() bool {
+	switch s {
+	case ReplyStatusDraft, ReplyStatusPending, ReplyStatusSent, ReplyStatusReceived, ReplyStatusFailed:
+		return true
+	}
+	return false
+}
+
+// UserLevel is the permission level of a user.
+type UserLevel string
+
+const (
+	UserLevelAgent            UserLevel = "agent"
+	UserLevelReporting        UserLevel = "reporting"
+	UserLevelSiteAdmin        UserLevel = "siteadmin"
+	UserLevelSiteAdminBilling UserLevel = "siteadmin_billing"
+)
+
+func (l UserLevel) Ptr() *UserLevel {
+	return &l
+}
+
+func (l UserLevel) IsKnown() bool {
+	switch l {
+	case UserLevelAgent, UserLevelReporting, UserLevelSiteAdmin, UserLevelSiteAdminBilling:
+		return true
+	}
+	return false
+}
+
+// LabelType is a kind of object a label can be applied to.
+type LabelType string
+
+const (
+	LabelTypeCase  LabelType = "case"
+	LabelTypeMacro LabelType = "macro"
+)
+
+func (t LabelType) IsKnown() bool {
+	return t == LabelTypeCase || t == LabelTypeMacro
+}
+
+// UpdateAction tells Desk how to apply a list sent in an update, such as the
+// emails of a customer or the domains of a company.
+type UpdateAction string
+
+const (
+	UpdateAppend  UpdateAction = "append"
+	UpdateReplace UpdateAction = "replace"
+	UpdateRemove  UpdateAction = "remove"
+)
+
+func (a UpdateAction) Ptr() *UpdateAction {
+	return &a
+}
+
+func (a UpdateAction) IsKnown() bool {
+	return a == UpdateAppend || a == UpdateReplace || a == UpdateRemove
+}
+
+// knownValue is implemented by the enum types. Unknown values decode without
+// error, so that new values added by Desk can still be read, but they are
+// rejected when sent to Desk.
+type knownValue interface {
+	IsKnown() bool
+}
+
+var knownValueType = reflect.TypeOf((*knownValue)(nil)).Elem()
+
+// EnumError is returned when a resource holding an unknown enum value is
+// sent to Desk.
+type EnumError struct {
+	Field string
+	Value string
+}
+
+func (e *EnumError) Error() string {
+	return fmt.Sprintf("desk: invalid value %#v for field %v", e.Value, e.Field)
+}
+
+// CheckEnums returns an *EnumError for the first enum field of model holding
+// an unknown value. When fields is not nil only the fields whose JSON keys
+// are in fields are checked, e.g. the keys of a Diff.
+func CheckEnums(model interface{}, fields map[string]interface{}) error {
+	v := reflect.Indirect(reflect.ValueOf(model))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	return checkEnums(v, "", fields)
+}
+
+func checkEnums(v reflect.Value, prefix string, fields map[string]interface{}) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		name := strings.Split(tag, ",")[0]
+		if tag == "-" || field.PkgPath != "" && !field.Anonymous {
+			continue
+		}
+		value := v.Field(i)
+		if field.Anonymous && name == "" && value.Kind() == reflect.Struct {
+			if err := checkEnums(value, prefix, fields); err != nil {
+				return err
+			}
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if prefix == "" && fields != nil {
+			if _, ok := fields[name]; !ok {
+				continue
+			}
+		}
+		if err := checkEnumValue(value, prefix+name, fields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkEnumValue(value reflect.Value, name string, fields map[string]interface{}) error {
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			return nil
+		}
+		return checkEnumValue(value.Elem(), name, fields)
+	case reflect.Slice:
+		for i := 0; i < value.Len(); i++ {
+			if err := checkEnumValue(value.Index(i), name, fields); err != nil {
+				return err
+			}
+		}
+	case reflect.String:
+		if value.Type().Implements(knownValueType) && !value.Interface().(knownValue).IsKnown() {
+			return &EnumError{Field: name, Value: value.String()}
+		}
+	case reflect.Struct:
+		if value.Type().PkgPath() != reflect.TypeOf(Resource{}).PkgPath() {
+			return nil
+		}
+		return checkEnums(value, name+".", nil)
+	}
+	return nil
+}
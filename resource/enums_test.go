@@ -0,0 +1,89 @@
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestEnums(t *testing.T) {
+	fmt.Println("")
+	Convey("Decoding", t, func() {
+		Convey("should keep known values", func() {
+			cse := NewCase()
+			err := json.Unmarshal([]byte(`{"status":"open","type":"email"}`), cse)
+			So(err, ShouldBeNil)
+			So(*cse.Status, ShouldEqual, CaseStatusOpen)
+			So(*cse.Type, ShouldEqual, CaseTypeEmail)
+			So(cse.Status.IsKnown(), ShouldBeTrue)
+		})
+		Convey("should keep unknown values", func() {
+			cse := NewCase()
+			err := json.Unmarshal([]byte(`{"status":"escalated"}`), cse)
+			So(err, ShouldBeNil)
+			So(*cse.Status, ShouldEqual, CaseStatus("escalated"))
+			So(cse.Status.IsKnown(), ShouldBeFalse)
+		})
+	})
+	Convey("CheckEnums", t, func() {
+		Convey("should accept known values", func() {
+			cse := NewCase()
+			cse.Status = CaseStatusPending.Ptr()
+			cse.Message = NewMessage()
+			cse.Message.Direction = DirectionIn.Ptr()
+			So(CheckEnums(cse, nil), ShouldBeNil)
+		})
+		Convey("should reject unknown values", func() {
+			user := NewUser()
+			user.Level = UserLevel("owner").Ptr()
+			err := CheckEnums(user, nil)
+			So(err, ShouldResemble, &EnumError{Field: "level", Value: "owner"})
+		})
+		Convey("should reject unknown values of nested resources", func() {
+			cse := NewCase()
+			cse.Message = NewMessage()
+			cse.Message.Status = ReplyStatus("bounced").Ptr()
+			err := CheckEnums(cse, nil)
+			So(err, ShouldResemble, &EnumError{Field: "message.status", Value: "bounced"})
+		})
+		Convey("should reject unknown values in slices", func() {
+			label := NewLabel()
+			label.Types = []LabelType{LabelTypeCase, "article"}
+			So(CheckEnums(label, nil), ShouldNotBeNil)
+		})
+		Convey("should only check the given fields", func() {
+			cse := NewCase()
+			cse.Status = CaseStatus("escalated").Ptr()
+			cse.Type = CaseTypeChat.Ptr()
+			So(CheckEnums(cse, map[string]interface{}{"type": "chat"}), ShouldBeNil)
+			So(CheckEnums(cse, map[string]interface{}{"status": "escalated"}), ShouldNotBeNil)
+		})
+		Convey("should fail MarshalForWrite", func() {
+			cse := NewCase()
+			cse.Status = CaseStatus("escalated").Ptr()
+			_, err := cse.MarshalForWrite(cse, OperationCreate)
+			So(err, ShouldHaveSameTypeAs, &EnumError{})
+		})
+	})
+	Convey("Builders", t, func() {
+		Convey("should convert strings set on enum fields", func() {
+			cse := CaseBuilder.
+				SetString("Type", "email").
+				SetString("Status", "new").
+				SetString("Subject", "Help").
+				BuildCase()
+			So(*cse.Type, ShouldEqual, CaseTypeEmail)
+			So(*cse.Status, ShouldEqual, CaseStatusNew)
+			So(*cse.Subject, ShouldEqual, "Help")
+		})
+		Convey("should set enum values", func() {
+			reply := ReplyBuilder.
+				SetValue("Direction", DirectionOut).
+				SetValue("Status", ReplyStatusDraft).
+				BuildReply()
+			So(*reply.Direction, ShouldEqual, DirectionOut)
+			So(*reply.Status, ShouldEqual, ReplyStatusDraft)
+		})
+	})
+}
@@ -0,0 +1,169 @@
+package resource
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Writable is implemented by resources that leave read-only data out when
+// they are sent to Desk. The client encodes request bodies implementing it
+// with MarshalForWrite.
+type Writable interface {
+	MarshalForWrite(model interface{}, op Operation) ([]byte, error)
+}
+
+// Extras returns the fields Desk sent that the resource does not model, by
+// their JSON keys. They are written back when the resource is encoded.
+func (r *Resource) Extras() map[string]interface{} {
+	extras := make(map[string]interface{}, len(r.extras))
+	for name := range r.extras {
+		extras[name], _ = r.Extra(name)
+	}
+	return extras
+}
+
+// Extra returns the value of a field the resource does not model, or false
+// if Desk did not send it.
+func (r *Resource) Extra(name string) (interface{}, bool) {
+	data, ok := r.extras[name]
+	if !ok {
+		return nil, false
+	}
+	var value interface{}
+	json.Unmarshal(data, &value)
+	return value, true
+}
+
+// SetExtra sets a field the resource does not model. Unlike the extras
+// decoded from Desk, extras set this way are sent in requests.
+func (r *Resource) SetExtra(name string, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	if r.extras == nil {
+		r.extras = make(map[string]json.RawMessage)
+	}
+	r.extras[name] = data
+	if r.writableExtras == nil {
+		r.writableExtras = make(map[string]bool)
+	}
+	r.writableExtras[name] = true
+	return nil
+}
+
+// DeleteExtra removes a field the resource does not model.
+func (r *Resource) DeleteExtra(name string) {
+	delete(r.extras, name)
+	delete(r.writableExtras, name)
+}
+
+// MarshalForWrite encodes model, the resource embedding r, for a request of
+// operation op. Fields not written in op, going by their desk tags, are left
+// out. So are the extras decoded from Desk: nothing tells whether Desk
+// accepts a field the resource does not model, so only extras set with
+// SetExtra are sent. An *EnumError is returned if an enum field holds an
+// unknown value.
+func (r *Resource) MarshalForWrite(model interface{}, op Operation) ([]byte, error) {
+	err := CheckEnums(model, nil)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(model)
+	if err != nil {
+		return nil, err
+	}
+	data, _, err = stripEncoded(reflect.Indirect(reflect.ValueOf(model)).Type(), data, op)
+	if err != nil {
+		return nil, err
+	}
+	decoded := make([]string, 0)
+	for name := range r.extras {
+		if !r.writableExtras[name] {
+			decoded = append(decoded, name)
+		}
+	}
+	if len(decoded) == 0 {
+		return data, nil
+	}
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range decoded {
+		delete(fields, name)
+	}
+	return json.Marshal(fields)
+}
+
+// unmarshalWithExtras decodes data into v, an alias of the resource embedding
+// r that does not implement json.Unmarshaler, keeping the fields v does not
+// model as extras.
+func (r *Resource) unmarshalWithExtras(data []byte, v interface{}) error {
+	err := json.Unmarshal(data, v)
+	if err != nil {
+		return err
+	}
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return nil
+	}
+	known := modeledFields(reflect.TypeOf(v).Elem())
+	r.extras = nil
+	r.writableExtras = nil
+	for name, value := range fields {
+		if known[strings.ToLower(name)] {
+			continue
+		}
+		if r.extras == nil {
+			r.extras = make(map[string]json.RawMessage)
+		}
+		r.extras[name] = value
+	}
+	return nil
+}
+
+var modeledFieldsCache = struct {
+	sync.Mutex
+	types map[reflect.Type]map[string]bool
+}{types: make(map[reflect.Type]map[string]bool)}
+
+// modeledFields returns the lower case JSON keys decoded into the fields of
+// struct type t, including the fields of embedded structs.
+func modeledFields(t reflect.Type) map[string]bool {
+	modeledFieldsCache.Lock()
+	defer modeledFieldsCache.Unlock()
+	if known, ok := modeledFieldsCache.types[t]; ok {
+		return known
+	}
+	known := make(map[string]bool)
+	addModeledFields(t, known)
+	modeledFieldsCache.types[t] = known
+	return known
+}
+
+func addModeledFields(t reflect.Type, known map[string]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			addModeledFields(field.Type, known)
+			continue
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		known[strings.ToLower(name)] = true
+	}
+}
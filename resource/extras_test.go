@@ -0,0 +1,74 @@
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestExtras(t *testing.T) {
+	fmt.Println("")
+	data := `{"subject":"Help","sentiment":"positive","first_response_at":"2015-01-02T03:04:05Z",
+		"replies_count":3,"_links":{"self":{"href":"/api/v2/cases/1","class":"case"}}}`
+	decode := func() *Case {
+		cse := NewCase()
+		json.Unmarshal([]byte(data), cse)
+		return cse
+	}
+	Convey("UnmarshalJSON", t, func() {
+		Convey("should keep unmodeled fields as extras", func() {
+			cse := decode()
+			So(*cse.Subject, ShouldEqual, "Help")
+			So(cse.GetResourceId(), ShouldEqual, "1")
+			So(cse.Extras(), ShouldResemble, map[string]interface{}{
+				"sentiment":         "positive",
+				"first_response_at": "2015-01-02T03:04:05Z",
+				"replies_count":     float64(3),
+			})
+			value, ok := cse.Extra("sentiment")
+			So(ok, ShouldBeTrue)
+			So(value, ShouldEqual, "positive")
+			_, ok = cse.Extra("subject")
+			So(ok, ShouldBeFalse)
+		})
+		Convey("should keep extras of page entries", func() {
+			raw := json.RawMessage(`[` + data + `]`)
+			entries := &EntryCollection{RawEntries: &raw}
+			entries.DecodeEntries("case")
+			cse := entries.Entries[0].(Case)
+			So(len(cse.Extras()), ShouldEqual, 3)
+		})
+	})
+	Convey("MarshalJSON", t, func() {
+		Convey("should write extras back", func() {
+			out, err := json.Marshal(decode())
+			So(err, ShouldBeNil)
+			So(string(out), ShouldEqual, `{"_links":{"self":{"class":"case","href":"/api/v2/cases/1"}},`+
+				`"first_response_at":"2015-01-02T03:04:05Z","replies_count":3,"sentiment":"positive","subject":"Help"}`)
+		})
+		Convey("should round trip an extras free resource unchanged", func() {
+			cse := NewCase()
+			json.Unmarshal([]byte(`{"subject":"Help","status":"open"}`), cse)
+			out, _ := json.Marshal(cse)
+			So(string(out), ShouldEqual, `{"status":"open","subject":"Help"}`)
+		})
+	})
+	Convey("MarshalForWrite", t, func() {
+		Convey("should leave out decoded extras", func() {
+			cse := decode()
+			out, err := cse.MarshalForWrite(cse, OperationUpdate)
+			So(err, ShouldBeNil)
+			So(string(out), ShouldEqual, `{"_links":{"self":{"class":"case","href":"/api/v2/cases/1"}},"subject":"Help"}`)
+		})
+		Convey("should keep extras set by the caller", func() {
+			cse := decode()
+			cse.SetExtra("escalated_at", "2015-02-01T00:00:00Z")
+			cse.DeleteExtra("sentiment")
+			out, _ := cse.MarshalForWrite(cse, OperationUpdate)
+			So(string(out), ShouldContainSubstring, `"escalated_at":"2015-02-01T00:00:00Z"`)
+			So(string(out), ShouldNotContainSubstring, `sentiment`)
+			So(string(out), ShouldNotContainSubstring, `replies_count`)
+		})
+	})
+}
@@ -0,0 +1,120 @@
+//go:build ignore
+// +build ignore
+
+// gen_json writes json_gen.go, the MarshalJSON and UnmarshalJSON methods of
+// every struct in the package that embeds Resource and does not encode
+// itself, such as RawResource. Run it with go generate.
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const output = "json_gen.go"
+
+var methods = template.Must(template.New("methods").Parse(`// Code generated by gen_json.go; DO NOT EDIT.
+
+package resource
+{{range .}}
+// MarshalJSON implements the json.Marshaler interface, see marshalFields.
+func (r {{.Name}}) MarshalJSON() ([]byte, error) {
+	type {{.Alias}} {{.Name}}
+	return r.marshalFields({{.Alias}}(r))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, see
+// unmarshalWithExtras.
+func (r *{{.Name}}) UnmarshalJSON(data []byte) error {
+	type {{.Alias}} {{.Name}}
+	return r.unmarshalWithExtras(data, (*{{.Alias}})(r))
+}
+{{end}}`))
+
+type resourceType struct {
+	Name  string
+	Alias string
+}
+
+func main() {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(info os.FileInfo) bool {
+		name := info.Name()
+		return !strings.HasSuffix(name, "_test.go") && name != output
+	}, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	embedding := make(map[string]bool)
+	encoding := make(map[string]bool)
+	for _, file := range pkgs["resource"].Files {
+		for _, decl := range file.Decls {
+			switch d := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					if ts, ok := spec.(*ast.TypeSpec); ok && embedsResource(ts) {
+						embedding[ts.Name.Name] = true
+					}
+				}
+			case *ast.FuncDecl:
+				if d.Recv != nil && (d.Name.Name == "MarshalJSON" || d.Name.Name == "UnmarshalJSON") {
+					encoding[receiverName(d.Recv.List[0].Type)] = true
+				}
+			}
+		}
+	}
+	types := make([]resourceType, 0)
+	for name := range embedding {
+		if !encoding[name] {
+			alias := strings.ToLower(name[:1]) + name[1:] + "JSON"
+			types = append(types, resourceType{Name: name, Alias: alias})
+		}
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i].Name < types[j].Name })
+
+	var buf bytes.Buffer
+	err = methods.Execute(&buf, types)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = ioutil.WriteFile(output, src, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func embedsResource(ts *ast.TypeSpec) bool {
+	st, ok := ts.Type.(*ast.StructType)
+	if !ok {
+		return false
+	}
+	for _, field := range st.Fields.List {
+		if ident, ok := field.Type.(*ast.Ident); ok && len(field.Names) == 0 && ident.Name == "Resource" {
+			return true
+		}
+	}
+	return false
+}
+
+func receiverName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
@@ -68,6 +68,13 @@ func (c *Hal) AddHrefLink(class string, href string) {
 	c.AddLinkSubItemStringValue(class, "class", class)
 }
 
+// AddHrefLinkWithClass adds a link whose name differs from the class of the
+// resource it points to, e.g. an assigned_user link to a user.
+func (c *Hal) AddHrefLinkWithClass(name string, class string, href string) {
+	c.AddLinkSubItemStringValue(name, "href", href)
+	c.AddLinkSubItemStringValue(name, "class", class)
+}
+
 func (c *Hal) GetHrefLink(class string) string {
 	var href string
 	if c.HasLinkAndSubItem(class, "href") {
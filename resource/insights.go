@@ -0,0 +1,210 @@
+package resource
+
+import (
+	"encoding/json"
+	. "github.com/wtlangford/go-desk/types"
+	"strings"
+	"time"
+)
+
+const (
+	ReportResolutionHours  = "hours"
+	ReportResolutionDays   = "days"
+	ReportResolutionWeeks  = "weeks"
+	ReportResolutionMonths = "months"
+)
+
+const (
+	ReportSortAsc  = "asc"
+	ReportSortDesc = "desc"
+)
+
+// Commonly requested report metrics. The full list of metrics available to
+// a site is returned by the insights meta endpoint.
+const (
+	ReportMetricCasesReceived       = "cases_received"
+	ReportMetricCasesResolved       = "cases_resolved"
+	ReportMetricCasesOpen           = "cases_open"
+	ReportMetricRepliesSent         = "replies_sent"
+	ReportMetricFirstResponseTime   = "first_response_time"
+	ReportMetricFirstResolutionTime = "first_resolution_time"
+)
+
+// ReportAllValues selects every value of a report dimension.
+const ReportAllValues = "*"
+
+// reportDateFormat is the layout used for the min_date and max_date fields.
+const reportDateFormat = "2006-01-02"
+
+// InsightsMeta describes the data available for reporting on a site.
+// See Desk API: http://dev.desk.com/API/insights/#meta
+type InsightsMeta struct {
+	StartDate   *Timestamp `json:"start_date,omitempty"`
+	Resolutions []string   `json:"resolutions,omitempty"`
+	Metrics     []string   `json:"metrics,omitempty"`
+	Dimensions  []string   `json:"dimensions,omitempty"`
+	Resource
+}
+
+func NewInsightsMeta() *InsightsMeta {
+	meta := &InsightsMeta{}
+	meta.InitializeResource(meta)
+	meta.ResourceName = "insights"
+	return meta
+}
+
+func (c InsightsMeta) String() string {
+	return Stringify(c)
+}
+
+// ReportQuery is the request body for an insights report.
+// See Desk API: http://dev.desk.com/API/insights/#reports
+type ReportQuery struct {
+	Resolution       *string  `json:"resolution,omitempty"`
+	MinDate          *string  `json:"min_date,omitempty"`
+	MaxDate          *string  `json:"max_date,omitempty"`
+	Dimension1Name   *string  `json:"dimension1_name,omitempty"`
+	Dimension1Values *string  `json:"dimension1_values,omitempty"`
+	Dimension2Name   *string  `json:"dimension2_name,omitempty"`
+	Dimension2Values *string  `json:"dimension2_values,omitempty"`
+	Metrics          []string `json:"metrics,omitempty"`
+	SortBy           *string  `json:"sort_by,omitempty"`
+	SortOrder        *string  `json:"sort_order,omitempty"`
+}
+
+func NewReportQuery() *ReportQuery {
+	return &ReportQuery{}
+}
+
+func (q ReportQuery) String() string {
+	return Stringify(q)
+}
+
+// SetDateRange limits the report to the days between from and to, inclusive.
+func (q *ReportQuery) SetDateRange(from time.Time, to time.Time) *ReportQuery {
+	q.MinDate = String(from.Format(reportDateFormat))
+	q.MaxDate = String(to.Format(reportDateFormat))
+	return q
+}
+
+func (q *ReportQuery) SetResolution(resolution string) *ReportQuery {
+	q.Resolution = String(resolution)
+	return q
+}
+
+// AddMetric appends metrics to the report. Metric names are listed in
+// InsightsMeta.Metrics.
+func (q *ReportQuery) AddMetric(metrics ...string) *ReportQuery {
+	q.Metrics = append(q.Metrics, metrics...)
+	return q
+}
+
+// GroupBy groups the report by a dimension. The first call sets the primary
+// dimension and the second call the secondary one; further calls replace the
+// secondary dimension. Without values every value of the dimension is used.
+func (q *ReportQuery) GroupBy(dimension string, values ...string) *ReportQuery {
+	selected := ReportAllValues
+	if len(values) > 0 {
+		selected = strings.Join(values, ",")
+	}
+	if q.Dimension1Name == nil {
+		q.Dimension1Name = String(dimension)
+		q.Dimension1Values = String(selected)
+	} else {
+		q.Dimension2Name = String(dimension)
+		q.Dimension2Values = String(selected)
+	}
+	return q
+}
+
+func (q *ReportQuery) SortedBy(metric string, order string) *ReportQuery {
+	q.SortBy = String(metric)
+	q.SortOrder = String(order)
+	return q
+}
+
+// ReportField describes a column of a report.
+type ReportField struct {
+	Name *string `json:"name,omitempty"`
+	Type *string `json:"type,omitempty"`
+}
+
+// ReportRow is a single row of report data. String values, such as the
+// dimension values a row is grouped by, are kept in Dimensions while numeric
+// values are kept in Metrics.
+type ReportRow struct {
+	Date       *string
+	Dimensions map[string]string
+	Metrics    map[string]float64
+}
+
+func (r ReportRow) String() string {
+	return Stringify(r)
+}
+
+// Metric returns the value of the named metric, or zero if the row does not
+// contain it.
+func (r ReportRow) Metric(name string) float64 {
+	return r.Metrics[name]
+}
+
+// Dimension returns the value of the named dimension, or an empty string if
+// the row does not contain it.
+func (r ReportRow) Dimension(name string) string {
+	return r.Dimensions[name]
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (r *ReportRow) UnmarshalJSON(data []byte) error {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.Dimensions = make(map[string]string)
+	r.Metrics = make(map[string]float64)
+	for k, v := range raw {
+		switch value := v.(type) {
+		case float64:
+			r.Metrics[k] = value
+		case string:
+			if k == "date" {
+				r.Date = String(value)
+			} else {
+				r.Dimensions[k] = value
+			}
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (r ReportRow) MarshalJSON() ([]byte, error) {
+	raw := make(map[string]interface{})
+	for k, v := range r.Dimensions {
+		raw[k] = v
+	}
+	for k, v := range r.Metrics {
+		raw[k] = v
+	}
+	if r.Date != nil {
+		raw["date"] = *r.Date
+	}
+	return json.Marshal(raw)
+}
+
+// Report holds the results of an insights report query.
+type Report struct {
+	Fields []ReportField `json:"fields,omitempty"`
+	Data   []ReportRow   `json:"data,omitempty"`
+	Resource
+}
+
+func NewReport() *Report {
+	report := &Report{}
+	report.InitializeResource(report)
+	return report
+}
+
+func (c Report) String() string {
+	return Stringify(c)
+}
@@ -0,0 +1,57 @@
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+	"time"
+)
+
+func TestInsights(t *testing.T) {
+	fmt.Println("")
+	Convey("ReportQuery", t, func() {
+		Convey("should format the date range as days", func() {
+			from := time.Date(2015, 3, 1, 12, 0, 0, 0, time.UTC)
+			to := time.Date(2015, 3, 31, 12, 0, 0, 0, time.UTC)
+			query := NewReportQuery().SetDateRange(from, to)
+			So(*query.MinDate, ShouldEqual, "2015-03-01")
+			So(*query.MaxDate, ShouldEqual, "2015-03-31")
+		})
+		Convey("should fill the primary and then the secondary dimension", func() {
+			query := NewReportQuery().
+				GroupBy("site").
+				GroupBy("channel", "email", "twitter")
+			So(*query.Dimension1Name, ShouldEqual, "site")
+			So(*query.Dimension1Values, ShouldEqual, ReportAllValues)
+			So(*query.Dimension2Name, ShouldEqual, "channel")
+			So(*query.Dimension2Values, ShouldEqual, "email,twitter")
+		})
+		Convey("should encode metrics as a list", func() {
+			query := NewReportQuery().AddMetric(ReportMetricCasesReceived, ReportMetricCasesResolved)
+			data, err := json.Marshal(query)
+			So(err, ShouldBeNil)
+			So(string(data), ShouldEqual, `{"metrics":["cases_received","cases_resolved"]}`)
+		})
+	})
+	Convey("ReportRow", t, func() {
+		Convey("should split dimensions and metrics", func() {
+			row := ReportRow{}
+			err := json.Unmarshal([]byte(`{"date":"2015-03-01","site":"support","cases_received":12,"first_response_time":360.5}`), &row)
+			So(err, ShouldBeNil)
+			So(*row.Date, ShouldEqual, "2015-03-01")
+			So(row.Dimension("site"), ShouldEqual, "support")
+			So(row.Metric("cases_received"), ShouldEqual, 12)
+			So(row.Metric("first_response_time"), ShouldEqual, 360.5)
+			So(row.Metric("missing"), ShouldEqual, 0)
+		})
+		Convey("should round trip through json", func() {
+			row := ReportRow{}
+			err := json.Unmarshal([]byte(`{"date":"2015-03-01","cases_resolved":3}`), &row)
+			So(err, ShouldBeNil)
+			data, err := json.Marshal(row)
+			So(err, ShouldBeNil)
+			So(string(data), ShouldEqual, `{"cases_resolved":3,"date":"2015-03-01"}`)
+		})
+	})
+}
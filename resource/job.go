@@ -4,13 +4,18 @@ import (
 	. "github.com/wtlangford/go-desk/types"
 )
 
+const (
+	JobTypeBulkCaseUpdate = "bulk_case_update"
+	JobTypeCustomerMerge  = "customer_merge"
+)
+
 type Job struct {
-	Type          *string    `json:"type,omitempty"`
-	StatusMessage *string    `json:"status_message,omitempty"`
-	Progress      float64    `json:"progress,omitempty"`
-	CreatedAt     *Timestamp `json:"created_at,omitempty"`
-	CompletedAt   *Timestamp `json:"completed_at,omitempty"`
-	LastError     *string    `json:"last_error,omitempty"`
+	Type          *string    `json:"type,omitempty" desk:"createonly"`
+	StatusMessage *string    `json:"status_message,omitempty" desk:"readonly"`
+	Progress      float64    `json:"progress,omitempty" desk:"readonly"`
+	CreatedAt     *Timestamp `json:"created_at,omitempty" desk:"readonly"`
+	CompletedAt   *Timestamp `json:"completed_at,omitempty" desk:"readonly"`
+	LastError     *string    `json:"last_error,omitempty" desk:"readonly"`
 	Resource
 }
 
@@ -3,6 +3,7 @@ package resource
 import (
 	"github.com/lann/builder"
 	. "github.com/wtlangford/go-desk/types"
+	"reflect"
 	"time"
 )
 
@@ -12,6 +13,14 @@ func (b jsonBuilder) SetString(field string, value string) jsonBuilder {
 	return builder.Set(b, field, &value).(jsonBuilder)
 }
 
+// SetValue sets a field to a copy of value, e.g. an enum such as
+// CaseStatusOpen.
+func (b jsonBuilder) SetValue(field string, value interface{}) jsonBuilder {
+	ptr := reflect.New(reflect.TypeOf(value))
+	ptr.Elem().Set(reflect.ValueOf(value))
+	return builder.Set(b, field, ptr.Interface()).(jsonBuilder)
+}
+
 func (b jsonBuilder) SetInt(field string, value int) jsonBuilder {
 	return builder.Set(b, field, &value).(jsonBuilder)
 }
@@ -21,7 +30,7 @@ func (b jsonBuilder) SetTimestamp(field string, value Timestamp) jsonBuilder {
 }
 
 func (b jsonBuilder) SetTimestampNow(field string) jsonBuilder {
-	timet := Timestamp{time.Now()}
+	timet := Timestamp{Time: time.Now()}
 	return builder.Set(b, field, &timet).(jsonBuilder)
 }
 
@@ -45,19 +54,19 @@ func (b jsonBuilder) AddCustomField(name string, value interface{}) jsonBuilder
 
 func (b jsonBuilder) AddEmail(value string, valueType string) jsonBuilder {
 	customer := builder.GetStructLike(b, Customer{}).(Customer)
-	customer.AddEmail(value, valueType)
+	customer.AddEmail(value, ContactType(valueType))
 	return builder.Set(b, "Emails", customer.Emails).(jsonBuilder)
 }
 
 func (b jsonBuilder) AddAddress(value string, valueType string) jsonBuilder {
 	customer := builder.GetStructLike(b, Customer{}).(Customer)
-	customer.AddAddress(value, valueType)
+	customer.AddAddress(value, ContactType(valueType))
 	return builder.Set(b, "Addresses", customer.Addresses).(jsonBuilder)
 }
 
 func (b jsonBuilder) AddPhoneNumber(value string, valueType string) jsonBuilder {
 	customer := builder.GetStructLike(b, Customer{}).(Customer)
-	customer.AddPhoneNumber(value, valueType)
+	customer.AddPhoneNumber(value, ContactType(valueType))
 	return builder.Set(b, "PhoneNumbers", customer.PhoneNumbers).(jsonBuilder)
 }
 
@@ -77,50 +86,74 @@ func (b jsonBuilder) AddDomain(value string) jsonBuilder {
 	return builder.Set(b, "Domains", company.Domains).(jsonBuilder)
 }
 
+// coerceStrings converts the strings set with SetString on enum fields of
+// strct, such as Case.Status, into the enum type of the field.
+func (b jsonBuilder) coerceStrings(strct interface{}) jsonBuilder {
+	t := reflect.TypeOf(strct)
+	for name, value := range builder.GetMap(b) {
+		str, ok := value.(*string)
+		if !ok || str == nil {
+			continue
+		}
+		field, ok := t.FieldByName(name)
+		if !ok || field.Type.Kind() != reflect.Ptr {
+			continue
+		}
+		elem := field.Type.Elem()
+		if elem.Kind() != reflect.String || elem == reflect.TypeOf("") {
+			continue
+		}
+		ptr := reflect.New(elem)
+		ptr.Elem().SetString(*str)
+		b = builder.Set(b, name, ptr.Interface()).(jsonBuilder)
+	}
+	return b
+}
+
 func (b jsonBuilder) BuildCustomer() Customer {
-	cust := builder.GetStructLike(b, Customer{}).(Customer)
+	cust := builder.GetStructLike(b.coerceStrings(Customer{}), Customer{}).(Customer)
 	cust.InitializeResource(cust)
 	return cust
 }
 
 func (b jsonBuilder) BuildMessage() Message {
-	msg := builder.GetStructLike(b, Message{}).(Message)
+	msg := builder.GetStructLike(b.coerceStrings(Message{}), Message{}).(Message)
 	msg.InitializeResource(msg)
 	return msg
 }
 
 func (b jsonBuilder) BuildReply() Reply {
-	rep := builder.GetStructLike(b, Reply{}).(Reply)
+	rep := builder.GetStructLike(b.coerceStrings(Reply{}), Reply{}).(Reply)
 	rep.InitializeResource(rep)
 	return rep
 }
 
 func (b jsonBuilder) BuildCase() Case {
-	cas := builder.GetStructLike(b, Case{}).(Case)
+	cas := builder.GetStructLike(b.coerceStrings(Case{}), Case{}).(Case)
 	cas.InitializeResource(cas)
 	return cas
 }
 
 func (b jsonBuilder) BuildDraft() Draft {
-	dra := builder.GetStructLike(b, Draft{}).(Draft)
+	dra := builder.GetStructLike(b.coerceStrings(Draft{}), Draft{}).(Draft)
 	dra.InitializeResource(dra)
 	return dra
 }
 
 func (b jsonBuilder) BuildNote() Note {
-	note := builder.GetStructLike(b, Note{}).(Note)
+	note := builder.GetStructLike(b.coerceStrings(Note{}), Note{}).(Note)
 	note.InitializeResource(note)
 	return note
 }
 
 func (b jsonBuilder) BuildCompany() Company {
-	cmp := builder.GetStructLike(b, Company{}).(Company)
+	cmp := builder.GetStructLike(b.coerceStrings(Company{}), Company{}).(Company)
 	cmp.InitializeResource(cmp)
 	return cmp
 }
 
 func (b jsonBuilder) BuildJob() Job {
-	jb := builder.GetStructLike(b, Job{}).(Job)
+	jb := builder.GetStructLike(b.coerceStrings(Job{}), Job{}).(Job)
 	jb.InitializeResource(jb)
 	return jb
 }
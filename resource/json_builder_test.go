@@ -20,7 +20,7 @@ func TestJsonBuilder(t *testing.T) {
 			So(*caze.Id, ShouldEqual, 99)
 		})
 		Convey("should set Timestamp fields", func() {
-			timet := Timestamp{time.Now()}
+			timet := Timestamp{Time: time.Now()}
 			caze := CaseBuilder.SetTimestamp("LockedUntil", timet).BuildCase()
 			So(caze.LockedUntil.String(), ShouldEqual, timet.String())
 		})
@@ -62,20 +62,20 @@ func TestJsonBuilder(t *testing.T) {
 		Convey("should add address", func() {
 			customer := CustomerBuilder.AddAddress("123 somewhere", "primary").BuildCustomer()
 			So(customer.Addresses, ShouldNotBeNil)
-			So(customer.Addresses[0]["value"], ShouldEqual, "123 somewhere")
-			So(customer.Addresses[0]["type"], ShouldEqual, "primary")
+			So(customer.Addresses[0].Value, ShouldEqual, "123 somewhere")
+			So(customer.Addresses[0].Type, ShouldEqual, ContactType("primary"))
 		})
 		Convey("should add email", func() {
 			customer := CustomerBuilder.AddEmail("me@me.com", "primary").BuildCustomer()
 			So(customer.Emails, ShouldNotBeNil)
-			So(customer.Emails[0]["value"], ShouldEqual, "me@me.com")
-			So(customer.Emails[0]["type"], ShouldEqual, "primary")
+			So(customer.Emails[0].Value, ShouldEqual, "me@me.com")
+			So(customer.Emails[0].Type, ShouldEqual, ContactType("primary"))
 		})
 		Convey("should add phone number", func() {
 			customer := CustomerBuilder.AddPhoneNumber("1231231234", "primary").BuildCustomer()
 			So(customer.PhoneNumbers, ShouldNotBeNil)
-			So(customer.PhoneNumbers[0]["value"], ShouldEqual, "1231231234")
-			So(customer.PhoneNumbers[0]["type"], ShouldEqual, "primary")
+			So(customer.PhoneNumbers[0].Value, ShouldEqual, "1231231234")
+			So(customer.PhoneNumbers[0].Type, ShouldEqual, ContactType("primary"))
 		})
 		Convey("should build Customer struct", func() {
 			customer := CustomerBuilder.BuildCustomer()
@@ -0,0 +1,198 @@
+// Code generated by gen_json.go; DO NOT EDIT.
+
+package resource
+
+// MarshalJSON implements the json.Marshaler interface, see marshalFields.
+func (r Attachment) MarshalJSON() ([]byte, error) {
+	type attachmentJSON Attachment
+	return r.marshalFields(attachmentJSON(r))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, see
+// unmarshalWithExtras.
+func (r *Attachment) UnmarshalJSON(data []byte) error {
+	type attachmentJSON Attachment
+	return r.unmarshalWithExtras(data, (*attachmentJSON)(r))
+}
+
+// MarshalJSON implements the json.Marshaler interface, see marshalFields.
+func (r Case) MarshalJSON() ([]byte, error) {
+	type caseJSON Case
+	return r.marshalFields(caseJSON(r))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, see
+// unmarshalWithExtras.
+func (r *Case) UnmarshalJSON(data []byte) error {
+	type caseJSON Case
+	return r.unmarshalWithExtras(data, (*caseJSON)(r))
+}
+
+// MarshalJSON implements the json.Marshaler interface, see marshalFields.
+func (r CaseEvent) MarshalJSON() ([]byte, error) {
+	type caseEventJSON CaseEvent
+	return r.marshalFields(caseEventJSON(r))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, see
+// unmarshalWithExtras.
+func (r *CaseEvent) UnmarshalJSON(data []byte) error {
+	type caseEventJSON CaseEvent
+	return r.unmarshalWithExtras(data, (*caseEventJSON)(r))
+}
+
+// MarshalJSON implements the json.Marshaler interface, see marshalFields.
+func (r Company) MarshalJSON() ([]byte, error) {
+	type companyJSON Company
+	return r.marshalFields(companyJSON(r))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, see
+// unmarshalWithExtras.
+func (r *Company) UnmarshalJSON(data []byte) error {
+	type companyJSON Company
+	return r.unmarshalWithExtras(data, (*companyJSON)(r))
+}
+
+// MarshalJSON implements the json.Marshaler interface, see marshalFields.
+func (r Customer) MarshalJSON() ([]byte, error) {
+	type customerJSON Customer
+	return r.marshalFields(customerJSON(r))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, see
+// unmarshalWithExtras.
+func (r *Customer) UnmarshalJSON(data []byte) error {
+	type customerJSON Customer
+	return r.unmarshalWithExtras(data, (*customerJSON)(r))
+}
+
+// MarshalJSON implements the json.Marshaler interface, see marshalFields.
+func (r Draft) MarshalJSON() ([]byte, error) {
+	type draftJSON Draft
+	return r.marshalFields(draftJSON(r))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, see
+// unmarshalWithExtras.
+func (r *Draft) UnmarshalJSON(data []byte) error {
+	type draftJSON Draft
+	return r.unmarshalWithExtras(data, (*draftJSON)(r))
+}
+
+// MarshalJSON implements the json.Marshaler interface, see marshalFields.
+func (r Group) MarshalJSON() ([]byte, error) {
+	type groupJSON Group
+	return r.marshalFields(groupJSON(r))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, see
+// unmarshalWithExtras.
+func (r *Group) UnmarshalJSON(data []byte) error {
+	type groupJSON Group
+	return r.unmarshalWithExtras(data, (*groupJSON)(r))
+}
+
+// MarshalJSON implements the json.Marshaler interface, see marshalFields.
+func (r InsightsMeta) MarshalJSON() ([]byte, error) {
+	type insightsMetaJSON InsightsMeta
+	return r.marshalFields(insightsMetaJSON(r))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, see
+// unmarshalWithExtras.
+func (r *InsightsMeta) UnmarshalJSON(data []byte) error {
+	type insightsMetaJSON InsightsMeta
+	return r.unmarshalWithExtras(data, (*insightsMetaJSON)(r))
+}
+
+// MarshalJSON implements the json.Marshaler interface, see marshalFields.
+func (r Job) MarshalJSON() ([]byte, error) {
+	type jobJSON Job
+	return r.marshalFields(jobJSON(r))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, see
+// unmarshalWithExtras.
+func (r *Job) UnmarshalJSON(data []byte) error {
+	type jobJSON Job
+	return r.unmarshalWithExtras(data, (*jobJSON)(r))
+}
+
+// MarshalJSON implements the json.Marshaler interface, see marshalFields.
+func (r Label) MarshalJSON() ([]byte, error) {
+	type labelJSON Label
+	return r.marshalFields(labelJSON(r))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, see
+// unmarshalWithExtras.
+func (r *Label) UnmarshalJSON(data []byte) error {
+	type labelJSON Label
+	return r.unmarshalWithExtras(data, (*labelJSON)(r))
+}
+
+// MarshalJSON implements the json.Marshaler interface, see marshalFields.
+func (r Message) MarshalJSON() ([]byte, error) {
+	type messageJSON Message
+	return r.marshalFields(messageJSON(r))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, see
+// unmarshalWithExtras.
+func (r *Message) UnmarshalJSON(data []byte) error {
+	type messageJSON Message
+	return r.unmarshalWithExtras(data, (*messageJSON)(r))
+}
+
+// MarshalJSON implements the json.Marshaler interface, see marshalFields.
+func (r Note) MarshalJSON() ([]byte, error) {
+	type noteJSON Note
+	return r.marshalFields(noteJSON(r))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, see
+// unmarshalWithExtras.
+func (r *Note) UnmarshalJSON(data []byte) error {
+	type noteJSON Note
+	return r.unmarshalWithExtras(data, (*noteJSON)(r))
+}
+
+// MarshalJSON implements the json.Marshaler interface, see marshalFields.
+func (r Reply) MarshalJSON() ([]byte, error) {
+	type replyJSON Reply
+	return r.marshalFields(replyJSON(r))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, see
+// unmarshalWithExtras.
+func (r *Reply) UnmarshalJSON(data []byte) error {
+	type replyJSON Reply
+	return r.unmarshalWithExtras(data, (*replyJSON)(r))
+}
+
+// MarshalJSON implements the json.Marshaler interface, see marshalFields.
+func (r Report) MarshalJSON() ([]byte, error) {
+	type reportJSON Report
+	return r.marshalFields(reportJSON(r))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, see
+// unmarshalWithExtras.
+func (r *Report) UnmarshalJSON(data []byte) error {
+	type reportJSON Report
+	return r.unmarshalWithExtras(data, (*reportJSON)(r))
+}
+
+// MarshalJSON implements the json.Marshaler interface, see marshalFields.
+func (r User) MarshalJSON() ([]byte, error) {
+	type userJSON User
+	return r.marshalFields(userJSON(r))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, see
+// unmarshalWithExtras.
+func (r *User) UnmarshalJSON(data []byte) error {
+	type userJSON User
+	return r.unmarshalWithExtras(data, (*userJSON)(r))
+}
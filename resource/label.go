@@ -5,13 +5,13 @@ import (
 )
 
 type Label struct {
-	Name        *string  `json:"name,omitempty"`
-	Description *string  `json:"description,omitempty"`
-	Color       *string  `json:"color,omitempty"`
-	Enabled     *bool    `json:"enabled,omitempty"`
-	Active      *bool    `json:"active,omitempty"`
-	Postion     *int     `json:"position,omitempty"`
-	Types       []string `json:"types,omitempty"`
+	Name        *string     `json:"name,omitempty"`
+	Description *string     `json:"description,omitempty"`
+	Color       *string     `json:"color,omitempty"`
+	Enabled     *bool       `json:"enabled,omitempty"`
+	Active      *bool       `json:"active,omitempty"`
+	Postion     *int        `json:"position,omitempty"`
+	Types       []LabelType `json:"types,omitempty"`
 	Resource
 }
 
@@ -24,3 +24,11 @@ func NewLabel() *Label {
 func (c Label) String() string {
 	return Stringify(c)
 }
+
+// Validate checks the label before it is written in op. A label needs a name.
+func (c *Label) Validate(op Operation) error {
+	errs := make(ValidationErrors)
+	errs.checkEnums(c, op)
+	errs.requireString("name", c.Name, op)
+	return errs.Err()
+}
@@ -0,0 +1,51 @@
+package resource
+
+// Link is a typed view of a HAL link. Count is set for links to
+// collections, such as the replies of a case, and nil otherwise.
+// See Desk API (http://dev.desk.com/API/using-the-api/#relationships)
+type Link struct {
+	Href  string
+	Class string
+	Count *int
+}
+
+// IsCollection reports whether the link points to a collection of resources
+// rather than a single resource.
+func (l Link) IsCollection() bool {
+	return l.Count != nil
+}
+
+// Linker is implemented by resources that carry HAL links.
+type Linker interface {
+	GetLink(name string) (Link, bool)
+}
+
+// GetLink returns the named link, or false if the resource has no such link
+// or the link is null.
+func (c *Hal) GetLink(name string) (Link, bool) {
+	if !c.HasLinkAndSubItem(name, "href") {
+		return Link{}, false
+	}
+	link := Link{}
+	link.Href, _ = c.Links[name]["href"].(string)
+	link.Class, _ = c.Links[name]["class"].(string)
+	switch count := c.Links[name]["count"].(type) {
+	case float64:
+		n := int(count)
+		link.Count = &n
+	case int:
+		link.Count = &count
+	}
+	return link, link.Href != ""
+}
+
+// GetLinks returns every non-null link of the resource by name.
+func (c *Hal) GetLinks() map[string]Link {
+	links := make(map[string]Link)
+	for name := range c.Links {
+		if link, ok := c.GetLink(name); ok {
+			links[name] = link
+		}
+	}
+	return links
+}
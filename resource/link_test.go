@@ -0,0 +1,48 @@
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestLink(t *testing.T) {
+	fmt.Println("")
+	data := `{"subject":"Hello","_links":{
+		"self":{"href":"/api/v2/cases/1","class":"case"},
+		"customer":{"href":"/api/v2/customers/2","class":"customer"},
+		"assigned_user":null,
+		"replies":{"href":"/api/v2/cases/1/replies","class":"reply","count":3}}}`
+	cse := NewCase()
+	json.Unmarshal([]byte(data), cse)
+	Convey("GetLink", t, func() {
+		Convey("should return a member link", func() {
+			link, ok := cse.GetLink("customer")
+			So(ok, ShouldBeTrue)
+			So(link.Href, ShouldEqual, "/api/v2/customers/2")
+			So(link.Class, ShouldEqual, "customer")
+			So(link.Count, ShouldBeNil)
+			So(link.IsCollection(), ShouldBeFalse)
+		})
+		Convey("should return a collection link with its count", func() {
+			link, ok := cse.GetLink("replies")
+			So(ok, ShouldBeTrue)
+			So(link.IsCollection(), ShouldBeTrue)
+			So(*link.Count, ShouldEqual, 3)
+		})
+		Convey("should not return null or missing links", func() {
+			_, ok := cse.GetLink("assigned_user")
+			So(ok, ShouldBeFalse)
+			_, ok = cse.GetLink("assigned_group")
+			So(ok, ShouldBeFalse)
+		})
+	})
+	Convey("GetLinks", t, func() {
+		Convey("should return every non-null link", func() {
+			links := cse.GetLinks()
+			So(len(links), ShouldEqual, 3)
+			So(links["self"].Class, ShouldEqual, "case")
+		})
+	})
+}
@@ -5,22 +5,22 @@ import (
 )
 
 type Message struct {
-	Direction        *string    `json:"direction,omitempty"`
-	Body             *string    `json:"body,omitempty"`
-	BodyText         *string    `json:"body_text,omitempty"`
-	BodyHtml         *string    `json:"body_html,omitempty"`
-	Headers          *string    `json:"headers,omitempty"`
-	HeadersRaw       *string    `json:"headers_raw,omitempty"`
-	Status           *string    `json:"status,omitempty"`
-	Subject          *string    `json:"subject,omitempty"`
-	To               *string    `json:"to,omitempty"`
-	From             *string    `json:"from,omitempty"`
-	Cc               *string    `json:"cc,omitempty"`
-	Bcc              *string    `json:"bcc,omitempty"`
-	ClientType       *string    `json:"client_type,omitempty"`
-	FromFacebookName *string    `json:"from_facebook_name,omitempty"`
-	CreatedAt        *Timestamp `json:"created_at,omitempty"`
-	UpdatedAt        *Timestamp `json:"updated_at,omitempty"`
+	Direction        *Direction   `json:"direction,omitempty"`
+	Body             *string      `json:"body,omitempty"`
+	BodyText         *string      `json:"body_text,omitempty"`
+	BodyHtml         *string      `json:"body_html,omitempty"`
+	Headers          *string      `json:"headers,omitempty"`
+	HeadersRaw       *string      `json:"headers_raw,omitempty"`
+	Status           *ReplyStatus `json:"status,omitempty"`
+	Subject          *string      `json:"subject,omitempty"`
+	To               *string      `json:"to,omitempty"`
+	From             *string      `json:"from,omitempty"`
+	Cc               *string      `json:"cc,omitempty"`
+	Bcc              *string      `json:"bcc,omitempty"`
+	ClientType       *string      `json:"client_type,omitempty" desk:"readonly"`
+	FromFacebookName *string      `json:"from_facebook_name,omitempty"`
+	CreatedAt        *Timestamp   `json:"created_at,omitempty" desk:"readonly"`
+	UpdatedAt        *Timestamp   `json:"updated_at,omitempty" desk:"readonly"`
 	Resource
 }
 
@@ -34,3 +34,15 @@ func NewMessage() *Message {
 func (c Message) String() string {
 	return Stringify(c)
 }
+
+// Validate checks the message before it is written in op. A new message needs
+// a direction and a body.
+func (c *Message) Validate(op Operation) error {
+	errs := make(ValidationErrors)
+	errs.checkEnums(c, op)
+	if op == OperationCreate && c.Direction == nil {
+		errs.Add("direction", ValidationBlank)
+	}
+	errs.requireString("body", c.Body, op)
+	return errs.Err()
+}
@@ -7,9 +7,9 @@ import (
 type Note struct {
 	Body          *string    `json:"body,omitempty"`
 	SuppressRules *bool      `json:"supress_rules,omitempty"`
-	ErasedAt      *Timestamp `json:"erased_at,omitempty"`
-	CreatedAt     *Timestamp `json:"created_at,omitempty"`
-	UpdatedAt     *Timestamp `json:"updated_at,omitempty"`
+	ErasedAt      *Timestamp `json:"erased_at,omitempty" desk:"readonly"`
+	CreatedAt     *Timestamp `json:"created_at,omitempty" desk:"readonly"`
+	UpdatedAt     *Timestamp `json:"updated_at,omitempty" desk:"readonly"`
 	Resource
 }
 
@@ -22,3 +22,10 @@ func NewNote() *Note {
 func (c Note) String() string {
 	return Stringify(c)
 }
+
+// Validate checks the note before it is written in op. A note needs a body.
+func (c *Note) Validate(op Operation) error {
+	errs := make(ValidationErrors)
+	errs.requireString("body", c.Body, op)
+	return errs.Err()
+}
@@ -0,0 +1,102 @@
+package resource
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// SetNull marks fields to be sent as null, which clears them on Desk. Fields
+// are named by their JSON keys, and keys of nested objects are separated by
+// dots, e.g. "locked_until" or "custom_fields.level". A field holding a value
+// when the resource is encoded is sent with that value instead.
+func (r *Resource) SetNull(fields ...string) {
+	if r.nulls == nil {
+		r.nulls = make(map[string]bool)
+	}
+	for _, field := range fields {
+		r.nulls[field] = true
+	}
+}
+
+// IsNull reports whether a field was marked with SetNull.
+func (r *Resource) IsNull(field string) bool {
+	return r.nulls[field]
+}
+
+// ClearNull removes the null marks of fields.
+func (r *Resource) ClearNull(fields ...string) {
+	for _, field := range fields {
+		delete(r.nulls, field)
+	}
+}
+
+// UnsetLink replaces a link with null, e.g. to unassign a case from a user.
+func (c *Hal) UnsetLink(name string) {
+	if c.Links == nil {
+		c.Links = make(map[string]map[string]interface{})
+	}
+	c.Links[name] = nil
+}
+
+// marshalFields encodes v, an alias of the resource embedding r that does
+// not implement json.Marshaler, adding its extras and the fields marked with
+// SetNull.
+func (r *Resource) marshalFields(v interface{}) ([]byte, error) {
+	if len(r.nulls) == 0 && len(r.extras) == 0 {
+		return json.Marshal(v)
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range r.extras {
+		if _, ok := fields[name]; !ok {
+			fields[name] = value
+		}
+	}
+	for field := range r.nulls {
+		fields, err = setNullField(fields, strings.Split(field, "."))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// setNullField sets the field at path to null unless it holds a value.
+func setNullField(fields map[string]json.RawMessage, path []string) (map[string]json.RawMessage, error) {
+	if fields == nil {
+		fields = make(map[string]json.RawMessage)
+	}
+	key := path[0]
+	value, ok := fields[key]
+	if len(path) == 1 {
+		if !ok {
+			fields[key] = json.RawMessage("null")
+		}
+		return fields, nil
+	}
+	var nested map[string]json.RawMessage
+	if ok && string(value) != "null" {
+		err := json.Unmarshal(value, &nested)
+		if err != nil {
+			// the field holds a value that is not an object
+			return fields, nil
+		}
+	}
+	nested, err := setNullField(nested, path[1:])
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(nested)
+	if err != nil {
+		return nil, err
+	}
+	fields[key] = data
+	return fields, nil
+}
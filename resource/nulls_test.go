@@ -0,0 +1,76 @@
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	. "github.com/wtlangford/go-desk/types"
+	"testing"
+)
+
+func TestNulls(t *testing.T) {
+	fmt.Println("")
+	Convey("SetNull", t, func() {
+		Convey("should encode marked fields as null", func() {
+			cse := NewCase()
+			cse.Subject = String("Help")
+			cse.SetNull("locked_until")
+			So(cse.IsNull("locked_until"), ShouldBeTrue)
+			data, err := json.Marshal(cse)
+			So(err, ShouldBeNil)
+			So(string(data), ShouldEqual, `{"locked_until":null,"subject":"Help"}`)
+		})
+		Convey("should encode nested fields as null", func() {
+			customer := NewCustomer()
+			customer.CustomFields = map[string]interface{}{"tier": "gold"}
+			customer.SetNull("custom_fields.level", "background")
+			data, _ := json.Marshal(customer)
+			So(string(data), ShouldEqual, `{"background":null,"custom_fields":{"level":null,"tier":"gold"}}`)
+		})
+		Convey("should prefer values over null", func() {
+			cse := NewCase()
+			cse.SetNull("subject")
+			cse.Subject = String("Help")
+			data, _ := json.Marshal(cse)
+			So(string(data), ShouldEqual, `{"subject":"Help"}`)
+		})
+		Convey("should be removed by ClearNull", func() {
+			cse := NewCase()
+			cse.SetNull("subject")
+			cse.ClearNull("subject")
+			data, _ := json.Marshal(cse)
+			So(string(data), ShouldEqual, `{}`)
+		})
+		Convey("should encode values as before without nulls", func() {
+			cse := NewCase()
+			cse.Subject = String("Help")
+			cse.Status = CaseStatusOpen.Ptr()
+			data, _ := json.Marshal(cse)
+			So(string(data), ShouldEqual, `{"status":"open","subject":"Help"}`)
+		})
+	})
+	Convey("ClearAssignedUser", t, func() {
+		Convey("should encode the link as null", func() {
+			cse := NewCase()
+			cse.ClearAssignedUser()
+			data, _ := json.Marshal(cse)
+			So(string(data), ShouldEqual, `{"_links":{"assigned_user":null}}`)
+		})
+	})
+	Convey("Diff", t, func() {
+		Convey("should contain cleared fields", func() {
+			cse := NewCase()
+			json.Unmarshal([]byte(`{"subject":"Help","locked_until":"2015-01-02T03:04:05Z",
+				"_links":{"assigned_user":{"href":"/api/v2/users/3","class":"user"}}}`), cse)
+			cse.TakeSnapshot(cse)
+			cse.LockedUntil = nil
+			cse.SetNull("locked_until")
+			cse.ClearAssignedUser()
+			diff, _ := cse.Diff(cse)
+			So(diff, ShouldResemble, map[string]interface{}{
+				"locked_until": nil,
+				"_links":       map[string]interface{}{"assigned_user": nil},
+			})
+		})
+	})
+}
@@ -2,9 +2,32 @@ package resource
 
 import (
 	"encoding/json"
+	"errors"
 	. "github.com/wtlangford/go-desk/types"
+	"net/http"
+	"net/url"
+	"strconv"
 )
 
+// DefaultPerPage is the page size Desk uses when per_page is not given.
+const DefaultPerPage = 50
+
+var (
+	// ErrNoPageLink is returned when navigating to a page the current page
+	// does not link to, e.g. the next page of the last page.
+	ErrNoPageLink = errors.New("desk: page has no link to the requested page")
+
+	// ErrPageNotNavigable is returned when navigating from a page that was
+	// not fetched through a service.
+	ErrPageNotNavigable = errors.New("desk: page has no navigator")
+)
+
+// PageNavigator fetches the page at a link href and decodes its entries the
+// same way the page it was linked from was decoded.
+type PageNavigator interface {
+	NavigatePage(href string) (*Page, *http.Response, error)
+}
+
 // EntryCollection holds the raw json data for embedded resources.
 // The RawEntries field is used internally and not something an
 // API user would typically want to access.
@@ -26,8 +49,87 @@ type Page struct {
 	TotalEntries *int                              `json:"total_entries,omitempty"`
 	Embedded     *EntryCollection                  `json:"_embedded,omitempty"`
 	Links        map[string]map[string]interface{} `json:"_links,omitempty"`
+	navigator    PageNavigator
 }
 
 func (c Page) String() string {
 	return Stringify(c)
 }
+
+// SetNavigator sets the navigator used by Next, Prev, First and Last. The
+// services set it on every page they return.
+func (c *Page) SetNavigator(navigator PageNavigator) {
+	c.navigator = navigator
+}
+
+// Next fetches the next page.
+func (c *Page) Next() (*Page, *http.Response, error) {
+	return c.navigate("next")
+}
+
+// Prev fetches the previous page.
+func (c *Page) Prev() (*Page, *http.Response, error) {
+	return c.navigate("previous")
+}
+
+// First fetches the first page.
+func (c *Page) First() (*Page, *http.Response, error) {
+	return c.navigate("first")
+}
+
+// Last fetches the last page.
+func (c *Page) Last() (*Page, *http.Response, error) {
+	return c.navigate("last")
+}
+
+func (c *Page) HasNext() bool {
+	return c.linkHref("next") != ""
+}
+
+func (c *Page) HasPrev() bool {
+	return c.linkHref("previous") != ""
+}
+
+// PerPage returns the page size, read from the per_page parameter of the
+// page links, or DefaultPerPage when the links do not specify it.
+func (c *Page) PerPage() int {
+	for _, name := range []string{"self", "first", "last"} {
+		u, err := url.Parse(c.linkHref(name))
+		if err != nil {
+			continue
+		}
+		perPage, err := strconv.Atoi(u.Query().Get("per_page"))
+		if err == nil && perPage > 0 {
+			return perPage
+		}
+	}
+	return DefaultPerPage
+}
+
+// TotalPages returns the number of pages needed for TotalEntries.
+func (c *Page) TotalPages() int {
+	if c.TotalEntries == nil || *c.TotalEntries <= 0 {
+		return 0
+	}
+	perPage := c.PerPage()
+	return (*c.TotalEntries + perPage - 1) / perPage
+}
+
+func (c *Page) navigate(name string) (*Page, *http.Response, error) {
+	href := c.linkHref(name)
+	if href == "" {
+		return nil, nil, ErrNoPageLink
+	}
+	if c.navigator == nil {
+		return nil, nil, ErrPageNotNavigable
+	}
+	return c.navigator.NavigatePage(href)
+}
+
+func (c *Page) linkHref(name string) string {
+	if c.Links == nil || c.Links[name] == nil {
+		return ""
+	}
+	href, _ := c.Links[name]["href"].(string)
+	return href
+}
@@ -0,0 +1,92 @@
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"testing"
+)
+
+type recordingNavigator struct {
+	hrefs []string
+}
+
+func (n *recordingNavigator) NavigatePage(href string) (*Page, *http.Response, error) {
+	n.hrefs = append(n.hrefs, href)
+	return new(Page), nil, nil
+}
+
+func pageFromJson(data string) *Page {
+	page := new(Page)
+	json.Unmarshal([]byte(data), page)
+	return page
+}
+
+func TestPage(t *testing.T) {
+	fmt.Println("")
+	middle := `{"page":2,"total_entries":120,"_links":{
+		"self":{"href":"/api/v2/cases?page=2&per_page=25","class":"page"},
+		"first":{"href":"/api/v2/cases?page=1&per_page=25","class":"page"},
+		"last":{"href":"/api/v2/cases?page=5&per_page=25","class":"page"},
+		"previous":{"href":"/api/v2/cases?page=1&per_page=25","class":"page"},
+		"next":{"href":"/api/v2/cases?page=3&per_page=25","class":"page"}}}`
+	last := `{"page":1,"total_entries":3,"_links":{
+		"self":{"href":"/api/v2/cases?page=1","class":"page"},
+		"previous":null,
+		"next":null}}`
+	Convey("HasNext and HasPrev", t, func() {
+		Convey("should be true when the page links to them", func() {
+			page := pageFromJson(middle)
+			So(page.HasNext(), ShouldBeTrue)
+			So(page.HasPrev(), ShouldBeTrue)
+		})
+		Convey("should be false for null links", func() {
+			page := pageFromJson(last)
+			So(page.HasNext(), ShouldBeFalse)
+			So(page.HasPrev(), ShouldBeFalse)
+		})
+	})
+	Convey("TotalPages", t, func() {
+		Convey("should use per_page from the links", func() {
+			page := pageFromJson(middle)
+			So(page.PerPage(), ShouldEqual, 25)
+			So(page.TotalPages(), ShouldEqual, 5)
+		})
+		Convey("should default to the Desk page size", func() {
+			page := pageFromJson(last)
+			So(page.PerPage(), ShouldEqual, DefaultPerPage)
+			So(page.TotalPages(), ShouldEqual, 1)
+		})
+		Convey("should be zero without entries", func() {
+			So(new(Page).TotalPages(), ShouldEqual, 0)
+		})
+	})
+	Convey("Next, Prev, First and Last", t, func() {
+		Convey("should navigate to the linked hrefs", func() {
+			navigator := &recordingNavigator{}
+			page := pageFromJson(middle)
+			page.SetNavigator(navigator)
+			page.Next()
+			page.Prev()
+			page.First()
+			page.Last()
+			So(navigator.hrefs, ShouldResemble, []string{
+				"/api/v2/cases?page=3&per_page=25",
+				"/api/v2/cases?page=1&per_page=25",
+				"/api/v2/cases?page=1&per_page=25",
+				"/api/v2/cases?page=5&per_page=25",
+			})
+		})
+		Convey("should fail without a link", func() {
+			page := pageFromJson(last)
+			page.SetNavigator(&recordingNavigator{})
+			_, _, err := page.Next()
+			So(err, ShouldEqual, ErrNoPageLink)
+		})
+		Convey("should fail without a navigator", func() {
+			_, _, err := pageFromJson(middle).Next()
+			So(err, ShouldEqual, ErrPageNotNavigable)
+		})
+	})
+}
@@ -0,0 +1,191 @@
+package resource
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Operation is the kind of request a resource is written in. Fields tagged
+// desk:"readonly" are never written, while fields tagged desk:"createonly"
+// are only written when the resource is created. Fields tagged
+// desk:"action=<list>", such as Customer.EmailsUpdateAction, hold the update
+// action of a list and are only written on update.
+type Operation int
+
+const (
+	OperationCreate Operation = iota
+	OperationUpdate
+)
+
+func (op Operation) String() string {
+	if op == OperationCreate {
+		return "create"
+	}
+	return "update"
+}
+
+// fieldRule describes how a field is written. nested is the struct type of
+// fields holding another resource, such as Case.Message. actionOf is the JSON
+// key of the list an update action applies to, which is sent along with the
+// action even when empty, so that replacing with nothing clears the list.
+type fieldRule struct {
+	readOnly   bool
+	createOnly bool
+	actionOf   string
+	index      []int
+	nested     reflect.Type
+}
+
+func (rule fieldRule) writable(op Operation) bool {
+	if rule.actionOf != "" {
+		return op == OperationUpdate
+	}
+	return !rule.readOnly && !(rule.createOnly && op != OperationCreate)
+}
+
+var fieldRulesCache = struct {
+	sync.Mutex
+	types map[reflect.Type]map[string]fieldRule
+}{types: make(map[reflect.Type]map[string]fieldRule)}
+
+// fieldRules returns the write rules of the fields of struct type t by their
+// JSON keys, including the fields of embedded structs.
+func fieldRules(t reflect.Type) map[string]fieldRule {
+	fieldRulesCache.Lock()
+	defer fieldRulesCache.Unlock()
+	if rules, ok := fieldRulesCache.types[t]; ok {
+		return rules
+	}
+	rules := make(map[string]fieldRule)
+	addFieldRules(t, nil, rules)
+	fieldRulesCache.types[t] = rules
+	return rules
+}
+
+func addFieldRules(t reflect.Type, index []int, rules map[string]fieldRule) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		fieldIndex := append(append([]int{}, index...), i)
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			addFieldRules(field.Type, fieldIndex, rules)
+			continue
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		access := field.Tag.Get("desk")
+		rule := fieldRule{readOnly: access == "readonly", createOnly: access == "createonly"}
+		if strings.HasPrefix(access, "action=") {
+			rule.actionOf = strings.TrimPrefix(access, "action=")
+		}
+		nested := field.Type
+		if nested.Kind() == reflect.Ptr {
+			nested = nested.Elem()
+		}
+		if nested.Kind() == reflect.Struct && nested.PkgPath() == reflect.TypeOf(Resource{}).PkgPath() {
+			rule.nested = nested
+		}
+		if rule.readOnly || rule.createOnly || rule.actionOf != "" || rule.nested != nil {
+			rule.index = fieldIndex
+			rules[name] = rule
+		}
+	}
+}
+
+// StripFields removes from fields, the encoded form of model such as a Diff,
+// the fields not written in op, including those of nested resources. A list
+// left out for being empty is added back when its update action is sent.
+func StripFields(model interface{}, fields map[string]interface{}, op Operation) {
+	t := reflect.Indirect(reflect.ValueOf(model)).Type()
+	if t.Kind() == reflect.Struct {
+		stripFields(t, fields, op)
+	}
+}
+
+func stripFields(t reflect.Type, fields map[string]interface{}, op Operation) {
+	for name, rule := range fieldRules(t) {
+		value, ok := fields[name]
+		if !ok {
+			continue
+		}
+		if !rule.writable(op) {
+			delete(fields, name)
+			continue
+		}
+		if nested, ok := value.(map[string]interface{}); ok && rule.nested != nil {
+			stripFields(rule.nested, nested, op)
+		}
+		if _, ok := fields[rule.actionOf]; rule.actionOf != "" && value != nil && !ok {
+			fields[rule.actionOf] = []interface{}{}
+		}
+	}
+}
+
+// stripEncoded removes the fields not written in op from data, the encoded
+// form of a value of struct type t, and adds the empty lists update actions
+// apply to. It reports whether any field was changed; data is returned as is
+// otherwise.
+func stripEncoded(t reflect.Type, data []byte, op Operation) ([]byte, bool, error) {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil || fields == nil {
+		return data, false, nil
+	}
+	changed := false
+	for name, rule := range fieldRules(t) {
+		value, ok := fields[name]
+		if !ok {
+			continue
+		}
+		if !rule.writable(op) {
+			delete(fields, name)
+			changed = true
+			continue
+		}
+		if _, ok := fields[rule.actionOf]; rule.actionOf != "" && string(value) != "null" && !ok {
+			fields[rule.actionOf] = json.RawMessage("[]")
+			changed = true
+		}
+		if rule.nested != nil {
+			stripped, ok, err := stripEncoded(rule.nested, value, op)
+			if err != nil {
+				return nil, false, err
+			}
+			if ok {
+				fields[name] = stripped
+				changed = true
+			}
+		}
+	}
+	if !changed {
+		return data, false, nil
+	}
+	data, err = json.Marshal(fields)
+	return data, err == nil, err
+}
+
+// ClearUpdateActions unsets the update actions of model, the fields tagged
+// desk:"action=<list>", so they apply to a single update. The services call
+// it once an update succeeded.
+func ClearUpdateActions(model interface{}) {
+	v := reflect.Indirect(reflect.ValueOf(model))
+	if v.Kind() != reflect.Struct {
+		return
+	}
+	for _, rule := range fieldRules(v.Type()) {
+		if rule.actionOf != "" {
+			field := v.FieldByIndex(rule.index)
+			field.Set(reflect.Zero(field.Type()))
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package resource
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	. "github.com/wtlangford/go-desk/types"
+	"testing"
+	"time"
+)
+
+func TestReadOnlyFields(t *testing.T) {
+	fmt.Println("")
+	now := &Timestamp{Time: time.Date(2015, 1, 2, 3, 4, 5, 0, time.UTC)}
+	sample := func() *Case {
+		cse := NewCase()
+		cse.Subject = String("Help")
+		cse.Type = CaseTypeEmail.Ptr()
+		cse.Blurb = String("Help me")
+		cse.CreatedAt = now
+		cse.Message = NewMessage()
+		cse.Message.Body = String("Please help")
+		cse.Message.CreatedAt = now
+		return cse
+	}
+	Convey("MarshalForWrite", t, func() {
+		Convey("should leave out read-only fields on create", func() {
+			cse := sample()
+			out, err := cse.MarshalForWrite(cse, OperationCreate)
+			So(err, ShouldBeNil)
+			So(string(out), ShouldEqual, `{"message":{"body":"Please help"},"subject":"Help","type":"email"}`)
+		})
+		Convey("should also leave out create-only fields on update", func() {
+			cse := sample()
+			out, _ := cse.MarshalForWrite(cse, OperationUpdate)
+			So(string(out), ShouldEqual, `{"subject":"Help"}`)
+		})
+		Convey("should keep the encoding of writable resources", func() {
+			cse := NewCase()
+			cse.Subject = String("Help")
+			cse.Priority = Integer(4)
+			out, _ := cse.MarshalForWrite(cse, OperationUpdate)
+			So(string(out), ShouldEqual, `{"subject":"Help","priority":4}`)
+		})
+	})
+	Convey("StripFields", t, func() {
+		Convey("should remove fields not written in the operation", func() {
+			fields := map[string]interface{}{
+				"subject":    "Help",
+				"type":       "email",
+				"updated_at": "2015-01-02T03:04:05Z",
+				"message":    map[string]interface{}{"body": "Please help", "created_at": "2015-01-02T03:04:05Z"},
+			}
+			StripFields(NewCase(), fields, OperationCreate)
+			So(fields, ShouldResemble, map[string]interface{}{
+				"subject": "Help",
+				"type":    "email",
+				"message": map[string]interface{}{"body": "Please help"},
+			})
+			StripFields(NewCase(), fields, OperationUpdate)
+			So(fields, ShouldResemble, map[string]interface{}{"subject": "Help"})
+		})
+	})
+	Convey("Operation", t, func() {
+		So(OperationCreate.String(), ShouldEqual, "create")
+		So(OperationUpdate.String(), ShouldEqual, "update")
+	})
+}
@@ -0,0 +1,182 @@
+package resource
+
+import (
+	"encoding/json"
+	. "github.com/wtlangford/go-desk/types"
+	"reflect"
+	"sync"
+)
+
+// Constructor returns a new, initialized resource ready to be decoded into.
+// It must return a pointer.
+type Constructor func() interface{}
+
+var registry = struct {
+	sync.RWMutex
+	classes map[string]Constructor
+}{
+	classes: map[string]Constructor{
+		"attachment": func() interface{} { return NewAttachment() },
+		"case":       func() interface{} { return NewCase() },
+		"case_event": func() interface{} { return NewCaseEvent() },
+		"company":    func() interface{} { return NewCompany() },
+		"customer":   func() interface{} { return NewCustomer() },
+		"draft":      func() interface{} { return NewDraft() },
+		"group":      func() interface{} { return NewGroup() },
+		"job":        func() interface{} { return NewJob() },
+		"label":      func() interface{} { return NewLabel() },
+		"message":    func() interface{} { return NewMessage() },
+		"note":       func() interface{} { return NewNote() },
+		"reply":      func() interface{} { return NewReply() },
+		"user":       func() interface{} { return NewUser() },
+	},
+}
+
+// Register maps a HAL class name to the constructor of the type its
+// resources decode into, replacing any constructor registered before. It lets
+// callers decode resources of endpoints the library does not cover.
+func Register(class string, constructor Constructor) {
+	registry.Lock()
+	defer registry.Unlock()
+	registry.classes[class] = constructor
+}
+
+// IsRegistered reports whether a constructor is registered for class.
+func IsRegistered(class string) bool {
+	registry.RLock()
+	defer registry.RUnlock()
+	_, ok := registry.classes[class]
+	return ok
+}
+
+// NewResource returns a new resource of the type registered for class, or a
+// *RawResource if no type is registered.
+func NewResource(class string) interface{} {
+	registry.RLock()
+	constructor, ok := registry.classes[class]
+	registry.RUnlock()
+	if !ok {
+		return NewRawResource(class)
+	}
+	return constructor()
+}
+
+// DecodeResource decodes a resource into the type registered for the class
+// of its self link. Resources of unknown classes decode into a *RawResource.
+func DecodeResource(data []byte) (interface{}, error) {
+	var self struct {
+		Links struct {
+			Self struct {
+				Class string `json:"class"`
+			} `json:"self"`
+		} `json:"_links"`
+	}
+	err := json.Unmarshal(data, &self)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeResourceAs(self.Links.Self.Class, data)
+}
+
+// DecodeResourceAs decodes a resource into the type registered for class,
+// regardless of the class of its self link. A snapshot is taken of tracked
+// resources.
+func DecodeResourceAs(class string, data []byte) (interface{}, error) {
+	res := NewResource(class)
+	err := json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	if tracked, ok := res.(Tracked); ok {
+		err = tracked.TakeSnapshot(res)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// DecodeEntries decodes the embedded entries of a page. When class is empty
+// each entry decodes by the class of its self link and is kept as a pointer,
+// as in a case feed. Otherwise every entry decodes into the type registered
+// for class and is kept as a value, as in a list of cases.
+func (c *EntryCollection) DecodeEntries(class string) error {
+	var raw []json.RawMessage
+	if c.RawEntries != nil {
+		err := json.Unmarshal(*c.RawEntries, &raw)
+		if err != nil {
+			return err
+		}
+	}
+	entries := make([]interface{}, len(raw))
+	for i, data := range raw {
+		if class == "" {
+			entry, err := DecodeResource(data)
+			if err != nil {
+				return err
+			}
+			entries[i] = entry
+			continue
+		}
+		entry, err := DecodeResourceAs(class, data)
+		if err != nil {
+			return err
+		}
+		entries[i] = reflect.Indirect(reflect.ValueOf(entry)).Interface()
+	}
+	c.Entries = entries
+	c.RawEntries = nil
+	return nil
+}
+
+// RawResource holds a resource of a class with no registered type. Its
+// attributes are kept undecoded in Fields while its links are available
+// through the embedded Resource.
+type RawResource struct {
+	Class  string
+	Fields map[string]interface{}
+	Resource
+}
+
+func NewRawResource(class string) *RawResource {
+	raw := &RawResource{Class: class, Fields: make(map[string]interface{})}
+	raw.InitializeResource(raw)
+	return raw
+}
+
+func (c RawResource) String() string {
+	return Stringify(c)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (c *RawResource) UnmarshalJSON(data []byte) error {
+	var fields map[string]interface{}
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+	err = json.Unmarshal(data, &c.Hal)
+	if err != nil {
+		return err
+	}
+	delete(fields, "_links")
+	c.Fields = fields
+	if c.Class == "" {
+		if link, ok := c.GetLink("self"); ok {
+			c.Class = link.Class
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (c RawResource) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]interface{})
+	for k, v := range c.Fields {
+		fields[k] = v
+	}
+	if c.Links != nil {
+		fields["_links"] = c.Links
+	}
+	return json.Marshal(fields)
+}
@@ -0,0 +1,80 @@
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+type Widget struct {
+	Size *string `json:"size,omitempty"`
+	Resource
+}
+
+func entriesFromJson(data string) *EntryCollection {
+	raw := json.RawMessage(data)
+	return &EntryCollection{RawEntries: &raw}
+}
+
+func TestRegistry(t *testing.T) {
+	fmt.Println("")
+	feed := `[
+		{"body":"A note","_links":{"self":{"href":"/api/v2/cases/1/notes/1","class":"note"}}},
+		{"body":"A reply","_links":{"self":{"href":"/api/v2/cases/1/replies/2","class":"reply"}}},
+		{"size":"large","_links":{"self":{"href":"/api/v2/widgets/3","class":"widget"}}}]`
+	Convey("DecodeEntries", t, func() {
+		Convey("should decode a feed by class into pointers", func() {
+			entries := entriesFromJson(feed)
+			So(entries.DecodeEntries(""), ShouldBeNil)
+			So(entries.RawEntries, ShouldBeNil)
+			So(*entries.Entries[0].(*Note).Body, ShouldEqual, "A note")
+			So(*entries.Entries[1].(*Reply).Body, ShouldEqual, "A reply")
+			raw := entries.Entries[2].(*RawResource)
+			So(raw.Class, ShouldEqual, "widget")
+			So(raw.Fields["size"], ShouldEqual, "large")
+			So(raw.GetResourceId(), ShouldEqual, "3")
+		})
+		Convey("should decode a list into values of the given class", func() {
+			entries := entriesFromJson(`[{"subject":"Help","_links":{"self":{"href":"/api/v2/cases/1","class":"case"}}}]`)
+			So(entries.DecodeEntries("case"), ShouldBeNil)
+			cse := entries.Entries[0].(Case)
+			So(*cse.Subject, ShouldEqual, "Help")
+			So(cse.GetResourceName(), ShouldEqual, "cases")
+		})
+		Convey("should decode no entries", func() {
+			entries := &EntryCollection{}
+			So(entries.DecodeEntries("case"), ShouldBeNil)
+			So(len(entries.Entries), ShouldEqual, 0)
+		})
+	})
+	Convey("Register", t, func() {
+		Convey("should decode registered classes into their type", func() {
+			So(IsRegistered("widget"), ShouldBeFalse)
+			Register("widget", func() interface{} {
+				widget := &Widget{}
+				widget.InitializeResource(widget)
+				return widget
+			})
+			defer func() {
+				registry.Lock()
+				delete(registry.classes, "widget")
+				registry.Unlock()
+			}()
+			So(IsRegistered("widget"), ShouldBeTrue)
+			entries := entriesFromJson(feed)
+			So(entries.DecodeEntries(""), ShouldBeNil)
+			widget := entries.Entries[2].(*Widget)
+			So(*widget.Size, ShouldEqual, "large")
+		})
+	})
+	Convey("RawResource", t, func() {
+		Convey("should encode its fields and links", func() {
+			res, err := DecodeResource([]byte(`{"size":"large","_links":{"self":{"href":"/api/v2/widgets/3","class":"widget"}}}`))
+			So(err, ShouldBeNil)
+			data, err := json.Marshal(res)
+			So(err, ShouldBeNil)
+			So(string(data), ShouldEqual, `{"_links":{"self":{"class":"widget","href":"/api/v2/widgets/3"}},"size":"large"}`)
+		})
+	})
+}
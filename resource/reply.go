@@ -5,30 +5,30 @@ import (
 )
 
 type Reply struct {
-	Direction        *string    `json:"direction,omitempty"`
-	Body             *string    `json:"body,omitempty"`
-	BodyText         *string    `json:"body_text,omitempty"`
-	BodyHtml         *string    `json:"body_html,omitempty"`
-	Headers          *string    `json:"headers,omitempty"`
-	HeadersRaw       *string    `json:"headers_raw,omitempty"`
-	Status           *string    `json:"status,omitempty"`
-	Subject          *string    `json:"subject,omitempty"`
-	To               *string    `json:"to,omitempty"`
-	From             *string    `json:"from,omitempty"`
-	Type             *string    `json:"type,omitempty"`
-	Cc               *string    `json:"cc,omitempty"`
-	Bcc              *string    `json:"bcc,omitempty"`
-	ClientType       *string    `json:"client_type,omitempty"`
-	FromFacebookName *string    `json:"from_facebook_name,omitempty"`
-	PublicUrl        *string    `json:"public_url,omitempty"`
-	IsBestAnswer     *bool      `json:"is_best_answer,omitempty"`
-	Rating           *float32   `json:"rating,omitempty"`
-	RatingCount      *int       `json:"rating_count,omitempty"`
-	RatingScore      *int       `json:"rating_score,omitempty"`
-	EnteredAt        *Timestamp `json:"entered_at,omitempty"`
-	HiddentAt        *Timestamp `json:"hidden_at,omitempty"`
-	CreatedAt        *Timestamp `json:"created_at,omitempty"`
-	UpdatedAt        *Timestamp `json:"updated_at,omitempty"`
+	Direction        *Direction   `json:"direction,omitempty" desk:"createonly"`
+	Body             *string      `json:"body,omitempty"`
+	BodyText         *string      `json:"body_text,omitempty"`
+	BodyHtml         *string      `json:"body_html,omitempty"`
+	Headers          *string      `json:"headers,omitempty"`
+	HeadersRaw       *string      `json:"headers_raw,omitempty"`
+	Status           *ReplyStatus `json:"status,omitempty"`
+	Subject          *string      `json:"subject,omitempty"`
+	To               *string      `json:"to,omitempty"`
+	From             *string      `json:"from,omitempty"`
+	Type             *string      `json:"type,omitempty"`
+	Cc               *string      `json:"cc,omitempty"`
+	Bcc              *string      `json:"bcc,omitempty"`
+	ClientType       *string      `json:"client_type,omitempty" desk:"readonly"`
+	FromFacebookName *string      `json:"from_facebook_name,omitempty"`
+	PublicUrl        *string      `json:"public_url,omitempty" desk:"readonly"`
+	IsBestAnswer     *bool        `json:"is_best_answer,omitempty" desk:"readonly"`
+	Rating           *float32     `json:"rating,omitempty" desk:"readonly"`
+	RatingCount      *int         `json:"rating_count,omitempty" desk:"readonly"`
+	RatingScore      *int         `json:"rating_score,omitempty" desk:"readonly"`
+	EnteredAt        *Timestamp   `json:"entered_at,omitempty" desk:"readonly"`
+	HiddentAt        *Timestamp   `json:"hidden_at,omitempty" desk:"readonly"`
+	CreatedAt        *Timestamp   `json:"created_at,omitempty" desk:"readonly"`
+	UpdatedAt        *Timestamp   `json:"updated_at,omitempty" desk:"readonly"`
 	Resource
 }
 
@@ -42,3 +42,12 @@ func NewReply() *Reply {
 func (c Reply) String() string {
 	return Stringify(c)
 }
+
+// Validate checks the reply before it is written in op. A new reply needs a
+// body.
+func (c *Reply) Validate(op Operation) error {
+	errs := make(ValidationErrors)
+	errs.checkEnums(c, op)
+	errs.requireString("body", c.Body, op)
+	return errs.Err()
+}
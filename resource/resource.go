@@ -1,5 +1,9 @@
 package resource
 
+import (
+	"encoding/json"
+)
+
 type Resourceful interface {
 	InitializeResource(model interface{})
 	GetResourceId() (id string)
@@ -8,9 +12,17 @@ type Resourceful interface {
 	GetResourcePath(resource Resourceful, options ...func(*ResourcePath)) (path ResourcePath)
 }
 
+// Resources embedding Resource encode through marshalFields and
+// unmarshalWithExtras; their JSON methods are generated into json_gen.go.
+//
+//go:generate go run gen_json.go
 type Resource struct {
 	Hal
 	Naming
+	snapshot       map[string]interface{}
+	nulls          map[string]bool
+	extras         map[string]json.RawMessage
+	writableExtras map[string]bool
 }
 
 func (r *Resource) InitializeResource(model interface{}) {
@@ -1,6 +1,8 @@
 package resource
 
 import (
+	"fmt"
+	desk "github.com/wtlangford/go-desk"
 	"strings"
 )
 
@@ -94,6 +96,12 @@ func (p *ResourcePath) SetPrefix(prefix string) *ResourcePath {
 	return p
 }
 
+// Href returns the absolute API path, e.g. /api/v2/customers/1, as used in
+// HAL links.
+func (p ResourcePath) Href() string {
+	return fmt.Sprintf("/api/%s/%s", desk.DeskApiVersion, p.Path())
+}
+
 func (p ResourcePath) String() string {
 	return p.Path()
 }
@@ -49,4 +49,10 @@ func TestResourcePath(t *testing.T) {
 			So(path.Path(), ShouldEqual, "cases/1/replies/draft")
 		})
 	})
+	Convey("Href", t, func() {
+		Convey("should prefix the path with the api version", func() {
+			path := NewIdentityResourcePath("1", NewCustomer())
+			So(path.Href(), ShouldEqual, "/api/v2/customers/1")
+		})
+	})
 }
@@ -8,14 +8,14 @@ type User struct {
 	Name           *string    `json:"name,omitempty"`
 	PublicName     *string    `json:"public_name,omitempty"`
 	Email          *string    `json:"email,omitempty"`
-	EmailVerified  *bool      `json:"email_verified,omitempty"`
+	EmailVerified  *bool      `json:"email_verified,omitempty" desk:"readonly"`
 	Available      *bool      `json:"available,omitempty"`
 	Avatar         *string    `json:"avatar,omitempty"`
-	Level          *string    `json:"level,omitempty"`
-	CreatedAt      *Timestamp `json:"created_at,omitempty"`
-	UpdatedAt      *Timestamp `json:"updated_at,omitempty"`
-	CurrentLoginAt *Timestamp `json:"current_login_at,omitempty"`
-	LastLoginAt    *Timestamp `json:"last_login_at,omitempty"`
+	Level          *UserLevel `json:"level,omitempty"`
+	CreatedAt      *Timestamp `json:"created_at,omitempty" desk:"readonly"`
+	UpdatedAt      *Timestamp `json:"updated_at,omitempty" desk:"readonly"`
+	CurrentLoginAt *Timestamp `json:"current_login_at,omitempty" desk:"readonly"`
+	LastLoginAt    *Timestamp `json:"last_login_at,omitempty" desk:"readonly"`
 	Resource
 }
 
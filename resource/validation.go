@@ -0,0 +1,125 @@
+package resource
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Error codes of ValidationErrors, as used by Desk.
+const (
+	ValidationBlank     = "blank"
+	ValidationInclusion = "inclusion"
+)
+
+// Validator is implemented by resources that can check their fields before
+// being written in op, sparing a request Desk would reject.
+type Validator interface {
+	Validate(op Operation) error
+}
+
+// ValidationErrors holds the error codes of invalid fields by their dotted
+// JSON paths, e.g. "message.direction" or "emails.0.value". It is returned by
+// Validate and built from the errors of a 422 response by FlattenErrors.
+type ValidationErrors map[string][]string
+
+// Add records code for field, unless field already has it.
+func (e ValidationErrors) Add(field string, code string) {
+	for _, c := range e[field] {
+		if c == code {
+			return
+		}
+	}
+	e[field] = append(e[field], code)
+}
+
+// Has reports whether field has an error.
+func (e ValidationErrors) Has(field string) bool {
+	return len(e[field]) > 0
+}
+
+// Err returns e, or nil when it holds no errors.
+func (e ValidationErrors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+func (e ValidationErrors) Error() string {
+	fields := make([]string, 0, len(e))
+	for field := range e {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for i, field := range fields {
+		fields[i] = fmt.Sprintf("%v %v", field, strings.Join(e[field], ", "))
+	}
+	return "desk: validation failed: " + strings.Join(fields, "; ")
+}
+
+// FlattenErrors converts the nested errors of a Desk 422 response, such as
+// {"emails":[{"value":["taken"]}]}, to ValidationErrors.
+func FlattenErrors(errors map[string]interface{}) ValidationErrors {
+	flat := make(ValidationErrors)
+	for name, value := range errors {
+		flattenError(flat, name, value)
+	}
+	return flat
+}
+
+func flattenError(flat ValidationErrors, path string, value interface{}) {
+	switch v := value.(type) {
+	case string:
+		flat.Add(path, v)
+	case []interface{}:
+		for i, item := range v {
+			if code, ok := item.(string); ok {
+				flat.Add(path, code)
+			} else {
+				flattenError(flat, fmt.Sprintf("%v.%d", path, i), item)
+			}
+		}
+	case map[string]interface{}:
+		for name, item := range v {
+			flattenError(flat, path+"."+name, item)
+		}
+	}
+}
+
+// requireString records field as blank when value is missing on create, or
+// set but empty.
+func (e ValidationErrors) requireString(field string, value *string, op Operation) {
+	if value == nil && op == OperationCreate || value != nil && *value == "" {
+		e.Add(field, ValidationBlank)
+	}
+}
+
+// requireNested validates a nested resource, recording its errors under
+// field, or records field as blank when it is missing on create.
+func (e ValidationErrors) requireNested(field string, nested Validator, missing bool, op Operation) {
+	if missing {
+		if op == OperationCreate {
+			e.Add(field, ValidationBlank)
+		}
+		return
+	}
+	if errs, ok := nested.Validate(op).(ValidationErrors); ok {
+		for name, codes := range errs {
+			for _, code := range codes {
+				e.Add(field+"."+name, code)
+			}
+		}
+	}
+}
+
+// checkEnums records the first enum field of model holding an unknown value,
+// when creating. Updates only check the changed fields, see CheckEnums.
+func (e ValidationErrors) checkEnums(model interface{}, op Operation) {
+	if op != OperationCreate {
+		return
+	}
+	if err, ok := CheckEnums(model, nil).(*EnumError); ok {
+		e.Add(err.Field, ValidationInclusion)
+	}
+}
@@ -0,0 +1,79 @@
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	. "github.com/wtlangford/go-desk/types"
+	"testing"
+)
+
+func TestValidation(t *testing.T) {
+	fmt.Println("")
+	Convey("Validate", t, func() {
+		Convey("should require a message and customer link for new cases", func() {
+			err := NewCase().Validate(OperationCreate)
+			So(err, ShouldResemble, ValidationErrors{
+				"message":         {ValidationBlank},
+				"_links.customer": {ValidationBlank},
+			})
+		})
+		Convey("should report nested errors by dotted path", func() {
+			cse := NewCase()
+			cse.AddHrefLink("customer", "/api/v2/customers/1")
+			cse.Message = NewMessage()
+			cse.Message.Status = ReplyStatus("bounced").Ptr()
+			err := cse.Validate(OperationCreate)
+			So(err, ShouldResemble, ValidationErrors{
+				"message.direction": {ValidationBlank},
+				"message.body":      {ValidationBlank},
+				"message.status":    {ValidationInclusion},
+			})
+		})
+		Convey("should accept a valid case", func() {
+			cse := NewCase()
+			cse.AddHrefLink("customer", "/api/v2/customers/1")
+			cse.Message = NewMessage()
+			cse.Message.Direction = DirectionIn.Ptr()
+			cse.Message.Body = String("Please help")
+			So(cse.Validate(OperationCreate), ShouldBeNil)
+		})
+		Convey("should only check the fields set on update", func() {
+			cse := NewCase()
+			So(cse.Validate(OperationUpdate), ShouldBeNil)
+			cse.Priority = Integer(11)
+			So(cse.Validate(OperationUpdate), ShouldResemble, ValidationErrors{"priority": {ValidationInclusion}})
+			company := NewCompany()
+			company.Name = String("")
+			So(company.Validate(OperationUpdate), ShouldResemble, ValidationErrors{"name": {ValidationBlank}})
+		})
+		Convey("should require a name or contact for new customers", func() {
+			customer := NewCustomer()
+			So(customer.Validate(OperationCreate), ShouldResemble, ValidationErrors{"first_name": {ValidationBlank}})
+			customer.AddEmail("ada@example.com", "work")
+			So(customer.Validate(OperationCreate), ShouldBeNil)
+		})
+	})
+	Convey("FlattenErrors", t, func() {
+		Convey("should flatten nested server errors", func() {
+			var errors map[string]interface{}
+			json.Unmarshal([]byte(`{"first_name":["blank"],"emails":[{"value":["taken","invalid"]}],"message":{"direction":["inclusion"]}}`), &errors)
+			So(FlattenErrors(errors), ShouldResemble, ValidationErrors{
+				"first_name":        {"blank"},
+				"emails.0.value":    {"taken", "invalid"},
+				"message.direction": {"inclusion"},
+			})
+		})
+	})
+	Convey("ValidationErrors", t, func() {
+		Convey("should list the fields in order", func() {
+			errs := ValidationErrors{"subject": {"blank"}, "message.body": {"blank", "too_long"}}
+			So(errs.Error(), ShouldEqual, "desk: validation failed: message.body blank, too_long; subject blank")
+			So(errs.Has("subject"), ShouldBeTrue)
+			So(errs.Has("priority"), ShouldBeFalse)
+		})
+		Convey("should not be an error when empty", func() {
+			So(ValidationErrors{}.Err(), ShouldBeNil)
+		})
+	})
+}
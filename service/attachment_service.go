@@ -1,7 +1,6 @@
 package service
 
 import (
-	"encoding/json"
 	. "github.com/wtlangford/go-desk/resource"
 	"net/http"
 )
@@ -10,11 +9,83 @@ type AttachmentService struct {
 	client *Client
 }
 
+// Get retrieves an attachment for a case.
+// See Desk API: http://dev.desk.com/API/cases/#attachments-show
 func (s *AttachmentService) Get(caseId string, attachId string) (*Attachment, *http.Response, error) {
+	return s.get(casePath(caseId), attachId)
+}
+
+// Create an attachment for a case.
+// See Desk API: http://dev.desk.com/API/cases/#attachments-create
+func (s *AttachmentService) Create(caseId string, attach *Attachment) (*Attachment, *http.Response, error) {
+	return s.create(casePath(caseId), attach)
+}
+
+// Delete an attachment for a case.
+// See Desk API: http://dev.desk.com/API/cases/#attachments-delete
+func (s *AttachmentService) Delete(caseId string, attachId string) (*http.Response, error) {
+	return s.delete(casePath(caseId), attachId)
+}
+
+// List attachments for a case.
+// See Desk API: http://dev.desk.com/API/cases/#attachments-list
+func (s *AttachmentService) List(caseId string, opts *ListOptions) (*Page, *http.Response, error) {
+	return s.list(casePath(caseId), opts)
+}
+
+// GetForMessage retrieves an attachment of the case message.
+// See Desk API: http://dev.desk.com/API/cases/#message-attachments-show
+func (s *AttachmentService) GetForMessage(caseId string, attachId string) (*Attachment, *http.Response, error) {
+	return s.get(caseMessagePath(caseId), attachId)
+}
+
+// CreateForMessage adds an attachment to the case message.
+// See Desk API: http://dev.desk.com/API/cases/#message-attachments-create
+func (s *AttachmentService) CreateForMessage(caseId string, attach *Attachment) (*Attachment, *http.Response, error) {
+	return s.create(caseMessagePath(caseId), attach)
+}
+
+// DeleteForMessage deletes an attachment of the case message.
+// See Desk API: http://dev.desk.com/API/cases/#message-attachments-delete
+func (s *AttachmentService) DeleteForMessage(caseId string, attachId string) (*http.Response, error) {
+	return s.delete(caseMessagePath(caseId), attachId)
+}
+
+// ListForMessage lists the attachments of the case message.
+// See Desk API: http://dev.desk.com/API/cases/#message-attachments-list
+func (s *AttachmentService) ListForMessage(caseId string, opts *ListOptions) (*Page, *http.Response, error) {
+	return s.list(caseMessagePath(caseId), opts)
+}
+
+// GetForReply retrieves an attachment of a case reply.
+// See Desk API: http://dev.desk.com/API/cases/#replies-attachments-show
+func (s *AttachmentService) GetForReply(caseId string, replyId string, attachId string) (*Attachment, *http.Response, error) {
+	return s.get(caseReplyPath(caseId, replyId), attachId)
+}
+
+// CreateForReply adds an attachment to a case reply.
+// See Desk API: http://dev.desk.com/API/cases/#replies-attachments-create
+func (s *AttachmentService) CreateForReply(caseId string, replyId string, attach *Attachment) (*Attachment, *http.Response, error) {
+	return s.create(caseReplyPath(caseId, replyId), attach)
+}
+
+// DeleteForReply deletes an attachment of a case reply.
+// See Desk API: http://dev.desk.com/API/cases/#replies-attachments-delete
+func (s *AttachmentService) DeleteForReply(caseId string, replyId string, attachId string) (*http.Response, error) {
+	return s.delete(caseReplyPath(caseId, replyId), attachId)
+}
+
+// ListForReply lists the attachments of a case reply.
+// See Desk API: http://dev.desk.com/API/cases/#replies-attachments-list
+func (s *AttachmentService) ListForReply(caseId string, replyId string, opts *ListOptions) (*Page, *http.Response, error) {
+	return s.list(caseReplyPath(caseId, replyId), opts)
+}
+
+func (s *AttachmentService) get(parent *ResourcePath, attachId string) (*Attachment, *http.Response, error) {
 	restful := Restful{}
 	attach := NewAttachment()
 	attachPath := NewIdentityResourcePath(attachId, NewAttachment())
-	path := NewIdentityResourcePath(caseId, NewCase()).AppendPath(attachPath)
+	path := parent.AppendPath(attachPath)
 	resp, err := restful.
 		Get(path.Path()).
 		Json(attach).
@@ -23,11 +94,15 @@ func (s *AttachmentService) Get(caseId string, attachId string) (*Attachment, *h
 	return attach, resp, err
 }
 
-func (s *AttachmentService) Create(caseId string, attach *Attachment) (*Attachment, *http.Response, error) {
+func (s *AttachmentService) create(parent *ResourcePath, attach *Attachment) (*Attachment, *http.Response, error) {
+	err := validate(attach, OperationCreate)
+	if err != nil {
+		return nil, nil, err
+	}
 	restful := Restful{}
 	createdAttachment := NewAttachment()
-	attachmentPath := NewResourcePath(createdAttachment)
-	path := NewIdentityResourcePath(caseId, NewCase()).AppendPath(attachmentPath)
+	attachmentPath := NewResourcePath(NewAttachment())
+	path := parent.AppendPath(attachmentPath)
 	resp, err := restful.
 		Post(path.Path()).
 		Body(attach).
@@ -37,10 +112,10 @@ func (s *AttachmentService) Create(caseId string, attach *Attachment) (*Attachme
 	return createdAttachment, resp, err
 }
 
-func (s *AttachmentService) Delete(caseId string, attachId string) (*http.Response, error) {
+func (s *AttachmentService) delete(parent *ResourcePath, attachId string) (*http.Response, error) {
 	restful := Restful{}
 	attachPath := NewIdentityResourcePath(attachId, NewAttachment())
-	path := NewIdentityResourcePath(caseId, NewCase()).AppendPath(attachPath)
+	path := parent.AppendPath(attachPath)
 	resp, err := restful.
 		Delete(path.Path()).
 		Client(s.client).
@@ -48,13 +123,18 @@ func (s *AttachmentService) Delete(caseId string, attachId string) (*http.Respon
 	return resp, err
 }
 
-func (s *AttachmentService) List(caseId string) (*Page, *http.Response, error) {
+func (s *AttachmentService) list(parent *ResourcePath, opts *ListOptions) (*Page, *http.Response, error) {
+	params, err := opts.paramsFor("attachment")
+	if err != nil {
+		return nil, nil, err
+	}
 	restful := Restful{}
 	page := new(Page)
-	path := NewIdentityResourcePath(caseId, NewCase()).SetAction("attachments")
+	path := parent.AppendPath(NewResourcePath(NewAttachment()))
 	resp, err := restful.
 		Get(path.Path()).
 		Json(page).
+		Params(params).
 		Client(s.client).
 		Do()
 	if err != nil {
@@ -64,20 +144,25 @@ func (s *AttachmentService) List(caseId string) (*Page, *http.Response, error) {
 	if err != nil {
 		return nil, nil, err
 	}
+	page.SetNavigator(newPageNavigator(s.client, s.unravelPage))
 	return page, resp, err
 }
 
 func (s *AttachmentService) unravelPage(page *Page) error {
-	attachments := new([]Attachment)
-	err := json.Unmarshal(*page.Embedded.RawEntries, &attachments)
-	if err != nil {
-		return err
-	}
-	page.Embedded.Entries = make([]interface{}, len(*attachments))
-	for i, v := range *attachments {
-		v.InitializeResource(v)
-		page.Embedded.Entries[i] = interface{}(v)
-	}
-	page.Embedded.RawEntries = nil
-	return err
+	return page.Embedded.DecodeEntries("attachment")
+}
+
+// casePath is the member path of a case, e.g. cases/1
+func casePath(caseId string) *ResourcePath {
+	return NewIdentityResourcePath(caseId, NewCase())
+}
+
+// caseMessagePath is the path of a case's message, e.g. cases/1/message
+func caseMessagePath(caseId string) *ResourcePath {
+	return casePath(caseId).SetNested(NewMessage())
+}
+
+// caseReplyPath is the member path of a case reply, e.g. cases/1/replies/2
+func caseReplyPath(caseId string, replyId string) *ResourcePath {
+	return casePath(caseId).SetAction("replies").SetSuffix(replyId)
 }
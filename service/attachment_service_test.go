@@ -0,0 +1,75 @@
+package service
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	. "github.com/wtlangford/go-desk/resource"
+	. "github.com/wtlangford/go-desk/types"
+	"testing"
+)
+
+func newAttachment() *Attachment {
+	attach := NewAttachment()
+	attach.FileName = String("test.png")
+	attach.ContentType = String("image/png")
+	attach.Content = String("aGVsbG8=")
+	return attach
+}
+
+func TestAttachmentService(t *testing.T) {
+	fmt.Println("")
+	attachment := `{"id":3,"file_name":"test.png","_links":{"self":{"href":"/api/v2/cases/1/attachments/3","class":"attachment"}}}`
+	page := `{"total_entries":1,"page":1,"_embedded":{"entries":[` + attachment + `]}}`
+	Convey("case attachments", t, func() {
+		Convey("should use the case path", func() {
+			client, server, requests := newTestClient(200, attachment, attachment, page, "")
+			defer server.Close()
+			client.Case.Attachment.Get("1", "3")
+			client.Case.Attachment.Create("1", newAttachment())
+			client.Case.Attachment.List("1", nil)
+			client.Case.Attachment.Delete("1", "3")
+			So((*requests)[0].Path, ShouldEqual, "/api/v2/cases/1/attachments/3")
+			So((*requests)[1].Path, ShouldEqual, "/api/v2/cases/1/attachments")
+			So((*requests)[2].Path, ShouldEqual, "/api/v2/cases/1/attachments")
+			So((*requests)[3].Path, ShouldEqual, "/api/v2/cases/1/attachments/3")
+		})
+	})
+	Convey("message attachments", t, func() {
+		Convey("should use the case message path", func() {
+			client, server, requests := newTestClient(200, attachment, attachment, page, "")
+			defer server.Close()
+			get, _, err := client.Case.Attachment.GetForMessage("1", "3")
+			So(err, ShouldBeNil)
+			So(*get.FileName, ShouldEqual, "test.png")
+			_, _, err = client.Case.Attachment.CreateForMessage("1", newAttachment())
+			So(err, ShouldBeNil)
+			list, _, err := client.Case.Attachment.ListForMessage("1", nil)
+			So(err, ShouldBeNil)
+			So(len(list.Embedded.Entries), ShouldEqual, 1)
+			_, err = client.Case.Attachment.DeleteForMessage("1", "3")
+			So(err, ShouldBeNil)
+			So((*requests)[0].Method, ShouldEqual, "GET")
+			So((*requests)[0].Path, ShouldEqual, "/api/v2/cases/1/message/attachments/3")
+			So((*requests)[1].Method, ShouldEqual, "POST")
+			So((*requests)[1].Path, ShouldEqual, "/api/v2/cases/1/message/attachments")
+			So((*requests)[2].Method, ShouldEqual, "GET")
+			So((*requests)[2].Path, ShouldEqual, "/api/v2/cases/1/message/attachments")
+			So((*requests)[3].Method, ShouldEqual, "DELETE")
+			So((*requests)[3].Path, ShouldEqual, "/api/v2/cases/1/message/attachments/3")
+		})
+	})
+	Convey("reply attachments", t, func() {
+		Convey("should use the case reply path", func() {
+			client, server, requests := newTestClient(200, attachment, attachment, page, "")
+			defer server.Close()
+			client.Case.Attachment.GetForReply("1", "2", "3")
+			client.Case.Attachment.CreateForReply("1", "2", newAttachment())
+			client.Case.Attachment.ListForReply("1", "2", nil)
+			client.Case.Attachment.DeleteForReply("1", "2", "3")
+			So((*requests)[0].Path, ShouldEqual, "/api/v2/cases/1/replies/2/attachments/3")
+			So((*requests)[1].Path, ShouldEqual, "/api/v2/cases/1/replies/2/attachments")
+			So((*requests)[2].Path, ShouldEqual, "/api/v2/cases/1/replies/2/attachments")
+			So((*requests)[3].Path, ShouldEqual, "/api/v2/cases/1/replies/2/attachments/3")
+		})
+	})
+}
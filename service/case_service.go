@@ -1,11 +1,11 @@
 package service
 
 import (
-	"bytes"
-	"encoding/json"
 	. "github.com/wtlangford/go-desk/resource"
+	. "github.com/wtlangford/go-desk/types"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 type CaseService struct {
@@ -28,14 +28,16 @@ func NewCaseService(httpClient *Client) *CaseService {
 }
 
 // Get retrieves a single case by ID.
+// Only the given fields are returned when any are selected.
 // See Desk API method show (http://dev.desk.com/API/cases/#show)
-func (s *CaseService) Get(id string) (*Case, *http.Response, error) {
+func (s *CaseService) Get(id string, fields ...CaseField) (*Case, *http.Response, error) {
 	restful := Restful{}
 	cse := NewCase()
 	path := NewIdentityResourcePath(id, cse)
 	resp, err := restful.
 		Get(path.Path()).
 		Json(cse).
+		Params(fieldParams(fieldList(fields))).
 		Client(s.client).
 		Do()
 	return cse, resp, err
@@ -43,7 +45,11 @@ func (s *CaseService) Get(id string) (*Case, *http.Response, error) {
 
 // List cases with filtering and pagination.
 // See Desk API method list (http://dev.desk.com/API/cases/#list)
-func (s *CaseService) List(params *url.Values) (*Page, *http.Response, error) {
+func (s *CaseService) List(opts *ListOptions) (*Page, *http.Response, error) {
+	params, err := opts.paramsFor("case")
+	if err != nil {
+		return nil, nil, err
+	}
 	restful := Restful{}
 	page := new(Page)
 	path := NewResourcePath(NewCase())
@@ -60,10 +66,18 @@ func (s *CaseService) List(params *url.Values) (*Page, *http.Response, error) {
 	if err != nil {
 		return nil, nil, err
 	}
+	page.SetNavigator(newPageNavigator(s.client, s.unravelPage))
 	return page, resp, err
 }
 
+// ListStream lists all cases, fetching upcoming pages concurrently. The Page
+// of opts is ignored. Close the stream when done with it.
+func (s *CaseService) ListStream(opts *ListOptions, prefetch *PrefetchOptions) *PageStream {
+	return listStream(s.client, s.List, opts, prefetch)
+}
+
 // Search for cases with filtering and pagination.
+// The optional q is a raw query string appended to the encoded params.
 // See Desk API method list (http://dev.desk.com/API/cases/#search)
 func (s *CaseService) Search(params *url.Values, q *string) (*Page, *http.Response, error) {
 	restful := Restful{}
@@ -83,10 +97,24 @@ func (s *CaseService) Search(params *url.Values, q *string) (*Page, *http.Respon
 	if err != nil {
 		return nil, nil, err
 	}
+	page.SetNavigator(newPageNavigator(s.client, s.unravelPage))
 	return page, resp, err
 }
 
-func (s *CaseService) Feed(id string, params *url.Values) (*Page, *http.Response, error) {
+// SearchWith searches cases with a typed query.
+func (s *CaseService) SearchWith(query *CaseQuery) (*Page, *http.Response, error) {
+	params, err := query.Params()
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.Search(params, nil)
+}
+
+func (s *CaseService) Feed(id string, opts *ListOptions) (*Page, *http.Response, error) {
+	params, err := opts.paramsFor("")
+	if err != nil {
+		return nil, nil, err
+	}
 	restful := Restful{}
 	page := new(Page)
 	path := NewIdentityResourcePath(id, NewCase()).SetAction("feed")
@@ -103,10 +131,15 @@ func (s *CaseService) Feed(id string, params *url.Values) (*Page, *http.Response
 	if err != nil {
 		return nil, nil, err
 	}
+	page.SetNavigator(newPageNavigator(s.client, s.unravelFeedPage))
 	return page, resp, err
 }
 
-func (s *CaseService) History(id string, params *url.Values) (*Page, *http.Response, error) {
+func (s *CaseService) History(id string, opts *ListOptions) (*Page, *http.Response, error) {
+	params, err := opts.paramsFor("case_event")
+	if err != nil {
+		return nil, nil, err
+	}
 	restful := Restful{}
 	page := new(Page)
 	path := NewIdentityResourcePath(id, NewCase()).SetAction("history")
@@ -123,10 +156,15 @@ func (s *CaseService) History(id string, params *url.Values) (*Page, *http.Respo
 	if err != nil {
 		return nil, nil, err
 	}
+	page.SetNavigator(newPageNavigator(s.client, s.unravelHistoryPage))
 	return page, resp, err
 }
 
-func (s *CaseService) Labels(id string, params *url.Values) (*Page, *http.Response, error) {
+func (s *CaseService) Labels(id string, opts *ListOptions) (*Page, *http.Response, error) {
+	params, err := opts.paramsFor("label")
+	if err != nil {
+		return nil, nil, err
+	}
 	restful := Restful{}
 	page := new(Page)
 	path := NewIdentityResourcePath(id, NewCase()).SetAction("labels")
@@ -143,12 +181,18 @@ func (s *CaseService) Labels(id string, params *url.Values) (*Page, *http.Respon
 	if err != nil {
 		return nil, nil, err
 	}
+	page.SetNavigator(newPageNavigator(s.client, s.unravelLabelPage))
 	return page, resp, err
 }
 
-// Create a case.(does not route through customer cases path)
+// Create a case. The case must link to a customer, see Case.SetCustomer, or
+// use CustomerService.CreateCase instead.
 // See Desk API: http://dev.desk.com/API/cases/#create
 func (s *CaseService) Create(cse *Case) (*Case, *http.Response, error) {
+	err := validate(cse, OperationCreate)
+	if err != nil {
+		return nil, nil, err
+	}
 	restful := Restful{}
 	createdCase := NewCase()
 	path := NewResourcePath(NewCase())
@@ -161,18 +205,19 @@ func (s *CaseService) Create(cse *Case) (*Case, *http.Response, error) {
 	return createdCase, resp, err
 }
 
-// Update a case.
+// Update a case. For a case fetched from Desk only the fields changed since
+// are sent, see Resource.Diff; a case built locally is sent whole. Once the
+// update succeeded, cse is the new baseline of later updates.
 // See Desk API: http://dev.desk.com/API/cases/#update
 func (s *CaseService) Update(cse *Case) (*Case, *http.Response, error) {
-	restful := Restful{}
-	updatedCase := NewCase()
-	path := NewIdentityResourcePath(cse.GetResourceId(), cse)
-	resp, err := restful.
-		Patch(path.Path()).
-		Body(cse).
-		Json(updatedCase).
-		Client(s.client).
-		Do()
+	body, err := changedFields(cse)
+	if err != nil {
+		return nil, nil, err
+	}
+	updatedCase, resp, err := s.patch(cse.GetResourceId(), body)
+	if err == nil {
+		updated(cse)
+	}
 	return updatedCase, resp, err
 }
 
@@ -204,88 +249,122 @@ func (s *CaseService) Forward(id string, recipients string, note string) (*http.
 	return resp, err
 }
 
-func (s *CaseService) unravelPage(page *Page) error {
-	cases := new([]Case)
-	err := json.Unmarshal(*page.Embedded.RawEntries, &cases)
-	if err != nil {
-		return err
-	}
-	page.Embedded.Entries = make([]interface{}, len(*cases))
-	for i, v := range *cases {
-		v.InitializeResource(v)
-		page.Embedded.Entries[i] = interface{}(v)
-	}
-	page.Embedded.RawEntries = nil
-	return err
+// Resolve sets the status of a case to resolved.
+func (s *CaseService) Resolve(id string) (*Case, *http.Response, error) {
+	return s.setStatus(id, CaseStatusResolved)
 }
 
-func (s *CaseService) unravelFeedPage(page *Page) error {
-	var container interface{}
+// Reopen sets the status of a case back to open.
+func (s *CaseService) Reopen(id string) (*Case, *http.Response, error) {
+	return s.setStatus(id, CaseStatusOpen)
+}
 
-	decoder := json.NewDecoder(bytes.NewReader(*page.Embedded.RawEntries))
-	decoder.UseNumber()
-	err := decoder.Decode(&container)
-	if err != nil {
-		return err
-	}
+// Close sets the status of a case to closed.
+func (s *CaseService) Close(id string) (*Case, *http.Response, error) {
+	return s.setStatus(id, CaseStatusClosed)
+}
 
-	feedItems := container.([]interface{})
-	page.Embedded.Entries = make([]interface{}, 0)
-	for _, v := range feedItems {
-		entry := v.(map[string]interface{})
-		links := entry["_links"].(map[string]interface{})
-		self := links["self"].(map[string]interface{})
-		remarshalled, err := json.Marshal(v)
-		if err != nil {
-			return err
-		}
-		switch self["class"] {
-		case "note":
-			note := NewNote()
-			err = json.Unmarshal(remarshalled, &note)
-			if err != nil {
-				return err
-			}
-			page.Embedded.Entries = append(page.Embedded.Entries, interface{}(note))
-		default:
-			reply := NewReply()
-			err = json.Unmarshal(remarshalled, &reply)
-			if err != nil {
-				return err
-			}
-			page.Embedded.Entries = append(page.Embedded.Entries, interface{}(reply))
-		}
-	}
+// AssignToUser assigns a case to a user.
+func (s *CaseService) AssignToUser(id string, userId string) (*Case, *http.Response, error) {
+	user := NewUser()
+	user.SetResourceId(userId)
+	cse := NewCase()
+	cse.SetAssignedUser(user)
+	return s.patch(id, cse)
+}
 
-	return err
+// AssignToGroup assigns a case to a group.
+func (s *CaseService) AssignToGroup(id string, groupId string) (*Case, *http.Response, error) {
+	group := NewGroup()
+	group.SetResourceId(groupId)
+	cse := NewCase()
+	cse.SetAssignedGroup(group)
+	return s.patch(id, cse)
+}
+
+// UnassignUser removes the user a case is assigned to.
+func (s *CaseService) UnassignUser(id string) (*Case, *http.Response, error) {
+	cse := NewCase()
+	cse.ClearAssignedUser()
+	return s.patch(id, cse)
+}
+
+// UnassignGroup removes the group a case is assigned to.
+func (s *CaseService) UnassignGroup(id string) (*Case, *http.Response, error) {
+	cse := NewCase()
+	cse.ClearAssignedGroup()
+	return s.patch(id, cse)
+}
+
+// AddLabels adds labels to a case, keeping the labels it already has.
+func (s *CaseService) AddLabels(id string, labels ...string) (*Case, *http.Response, error) {
+	return s.labelAction(id, LabelActionAppend, labels)
+}
+
+// RemoveLabels removes labels from a case, keeping its other labels.
+func (s *CaseService) RemoveLabels(id string, labels ...string) (*Case, *http.Response, error) {
+	return s.labelAction(id, LabelActionRemove, labels)
+}
+
+// Lock locks a case until the given time.
+func (s *CaseService) Lock(id string, until time.Time) (*Case, *http.Response, error) {
+	cse := NewCase()
+	cse.LockedUntil = &Timestamp{Time: until}
+	return s.patch(id, cse)
+}
+
+// Unlock removes the lock from a case.
+func (s *CaseService) Unlock(id string) (*Case, *http.Response, error) {
+	cse := NewCase()
+	cse.SetNull("locked_until")
+	return s.patch(id, cse)
+}
+
+// SetPriority changes the priority of a case.
+func (s *CaseService) SetPriority(id string, priority int) (*Case, *http.Response, error) {
+	cse := NewCase()
+	cse.Priority = Integer(priority)
+	return s.patch(id, cse)
+}
+
+func (s *CaseService) setStatus(id string, status CaseStatus) (*Case, *http.Response, error) {
+	cse := NewCase()
+	cse.Status = status.Ptr()
+	return s.patch(id, cse)
+}
+
+func (s *CaseService) labelAction(id string, action string, labels []string) (*Case, *http.Response, error) {
+	cse := NewCase()
+	cse.Labels = labels
+	cse.LabelAction = String(action)
+	return s.patch(id, cse)
+}
+
+func (s *CaseService) patch(id string, body interface{}) (*Case, *http.Response, error) {
+	restful := Restful{}
+	updatedCase := NewCase()
+	path := NewIdentityResourcePath(id, NewCase())
+	resp, err := restful.
+		Patch(path.Path()).
+		Body(body).
+		Json(updatedCase).
+		Client(s.client).
+		Do()
+	return updatedCase, resp, err
+}
+
+func (s *CaseService) unravelPage(page *Page) error {
+	return page.Embedded.DecodeEntries("case")
+}
+
+func (s *CaseService) unravelFeedPage(page *Page) error {
+	return page.Embedded.DecodeEntries("")
 }
 
 func (s *CaseService) unravelHistoryPage(page *Page) error {
-	caseEvents := new([]CaseEvent)
-	err := json.Unmarshal(*page.Embedded.RawEntries, &caseEvents)
-	if err != nil {
-		return err
-	}
-	page.Embedded.Entries = make([]interface{}, len(*caseEvents))
-	for i, v := range *caseEvents {
-		v.InitializeResource(v)
-		page.Embedded.Entries[i] = interface{}(v)
-	}
-	page.Embedded.RawEntries = nil
-	return err
+	return page.Embedded.DecodeEntries("case_event")
 }
 
 func (s *CaseService) unravelLabelPage(page *Page) error {
-	labels := new([]Label)
-	err := json.Unmarshal(*page.Embedded.RawEntries, &labels)
-	if err != nil {
-		return err
-	}
-	page.Embedded.Entries = make([]interface{}, len(*labels))
-	for i, v := range *labels {
-		v.InitializeResource(v)
-		page.Embedded.Entries[i] = interface{}(v)
-	}
-	page.Embedded.RawEntries = nil
-	return err
+	return page.Embedded.DecodeEntries("label")
 }
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	. "github.com/wtlangford/go-desk/resource"
+	. "github.com/wtlangford/go-desk/types"
+	"testing"
+	"time"
+)
+
+func TestCaseServiceActions(t *testing.T) {
+	fmt.Println("")
+	updated := `{"status":"open","_links":{"self":{"href":"/api/v2/cases/1","class":"case"}}}`
+	patchBody := func(call func(s *CaseService)) recordedRequest {
+		client, server, requests := newTestClient(200, updated)
+		defer server.Close()
+		call(client.Case)
+		So(len(*requests), ShouldEqual, 1)
+		So((*requests)[0].Method, ShouldEqual, "PATCH")
+		So((*requests)[0].Path, ShouldEqual, "/api/v2/cases/1")
+		return (*requests)[0]
+	}
+	Convey("status changes", t, func() {
+		Convey("Resolve should only send the status", func() {
+			req := patchBody(func(s *CaseService) { s.Resolve("1") })
+			So(req.Body, ShouldEqual, "{\"status\":\"resolved\"}\n")
+		})
+		Convey("Reopen should only send the status", func() {
+			req := patchBody(func(s *CaseService) { s.Reopen("1") })
+			So(req.Body, ShouldEqual, "{\"status\":\"open\"}\n")
+		})
+		Convey("Close should only send the status", func() {
+			req := patchBody(func(s *CaseService) { s.Close("1") })
+			So(req.Body, ShouldEqual, "{\"status\":\"closed\"}\n")
+		})
+	})
+	Convey("assignment", t, func() {
+		Convey("AssignToUser should send the assigned_user link", func() {
+			req := patchBody(func(s *CaseService) { s.AssignToUser("1", "7") })
+			So(req.Body, ShouldEqual, "{\"_links\":{\"assigned_user\":{\"class\":\"user\",\"href\":\"/api/v2/users/7\"}}}\n")
+		})
+		Convey("AssignToGroup should send the assigned_group link", func() {
+			req := patchBody(func(s *CaseService) { s.AssignToGroup("1", "3") })
+			So(req.Body, ShouldEqual, "{\"_links\":{\"assigned_group\":{\"class\":\"group\",\"href\":\"/api/v2/groups/3\"}}}\n")
+		})
+	})
+	Convey("labels", t, func() {
+		Convey("AddLabels should append", func() {
+			req := patchBody(func(s *CaseService) { s.AddLabels("1", "vip", "billing") })
+			So(req.Body, ShouldEqual, "{\"labels\":[\"vip\",\"billing\"],\"label_action\":\"append\"}\n")
+		})
+		Convey("RemoveLabels should remove", func() {
+			req := patchBody(func(s *CaseService) { s.RemoveLabels("1", "vip") })
+			So(req.Body, ShouldEqual, "{\"labels\":[\"vip\"],\"label_action\":\"remove\"}\n")
+		})
+	})
+	Convey("locking", t, func() {
+		Convey("Lock should send locked_until", func() {
+			until := time.Date(2015, 3, 1, 10, 0, 0, 0, time.UTC)
+			req := patchBody(func(s *CaseService) { s.Lock("1", until) })
+			So(req.Body, ShouldEqual, "{\"locked_until\":\"2015-03-01T10:00:00Z\"}\n")
+		})
+		Convey("Unlock should clear locked_until", func() {
+			req := patchBody(func(s *CaseService) { s.Unlock("1") })
+			So(req.Body, ShouldEqual, "{\"locked_until\":null}\n")
+		})
+	})
+	Convey("unassigning", t, func() {
+		Convey("UnassignUser should send a null assigned_user link", func() {
+			req := patchBody(func(s *CaseService) { s.UnassignUser("1") })
+			So(req.Body, ShouldEqual, "{\"_links\":{\"assigned_user\":null}}\n")
+		})
+		Convey("UnassignGroup should send a null assigned_group link", func() {
+			req := patchBody(func(s *CaseService) { s.UnassignGroup("1") })
+			So(req.Body, ShouldEqual, "{\"_links\":{\"assigned_group\":null}}\n")
+		})
+	})
+	Convey("SetPriority", t, func() {
+		Convey("should only send the priority", func() {
+			req := patchBody(func(s *CaseService) { s.SetPriority("1", 8) })
+			So(req.Body, ShouldEqual, "{\"priority\":8}\n")
+		})
+	})
+	Convey("Update", t, func() {
+		escalated := `{"subject":"Help","status":"escalated","_links":{"self":{"href":"/api/v2/cases/1","class":"case"}}}`
+		Convey("should send unknown enum values left unchanged", func() {
+			client, server, requests := newTestClient(200, escalated, escalated)
+			defer server.Close()
+			cse, _, err := client.Case.Get("1")
+			So(err, ShouldBeNil)
+			cse.Subject = String("Still need help")
+			_, _, err = client.Case.Update(cse)
+			So(err, ShouldBeNil)
+			So((*requests)[1].Body, ShouldEqual, "{\"subject\":\"Still need help\"}\n")
+		})
+		Convey("should send only the fields changed since the last update", func() {
+			client, server, requests := newTestClient(200, escalated, escalated, escalated)
+			defer server.Close()
+			cse, _, _ := client.Case.Get("1")
+			cse.Subject = String("Still need help")
+			_, _, err := client.Case.Update(cse)
+			So(err, ShouldBeNil)
+			cse.Priority = Integer(8)
+			_, _, err = client.Case.Update(cse)
+			So(err, ShouldBeNil)
+			So((*requests)[2].Body, ShouldEqual, "{\"priority\":8}\n")
+		})
+		Convey("should reject changed enum fields with unknown values", func() {
+			client, server, requests := newTestClient(200, updated)
+			defer server.Close()
+			cse, _, _ := client.Case.Get("1")
+			cse.Status = CaseStatus("escalated").Ptr()
+			_, _, err := client.Case.Update(cse)
+			So(err, ShouldResemble, &EnumError{Field: "status", Value: "escalated"})
+			So(len(*requests), ShouldEqual, 1)
+		})
+		Convey("should not send read-only or create-only fields", func() {
+			client, server, requests := newTestClient(200, escalated, escalated)
+			defer server.Close()
+			cse, _, _ := client.Case.Get("1")
+			cse.Subject = String("Still need help")
+			cse.Blurb = String("Still need")
+			cse.Type = CaseTypeChat.Ptr()
+			_, _, err := client.Case.Update(cse)
+			So(err, ShouldBeNil)
+			So((*requests)[1].Body, ShouldEqual, "{\"subject\":\"Still need help\"}\n")
+		})
+	})
+	Convey("Create", t, func() {
+		Convey("should not send an invalid case", func() {
+			client, server, requests := newTestClient(201, updated)
+			defer server.Close()
+			_, _, err := client.Case.Create(NewCase())
+			errs, ok := AsValidationErrors(err)
+			So(ok, ShouldBeTrue)
+			So(errs.Has("message"), ShouldBeTrue)
+			So(errs.Has("_links.customer"), ShouldBeTrue)
+			So(len(*requests), ShouldEqual, 0)
+		})
+		Convey("should flatten the errors of a 422 response", func() {
+			client, server, _ := newTestClient(422, `{"message":"Validation Failed","errors":{"message":{"to":["invalid"]}}}`)
+			defer server.Close()
+			cse := NewCase()
+			cse.AddHrefLink("customer", "/api/v2/customers/1")
+			cse.Message = NewMessage()
+			cse.Message.Direction = DirectionIn.Ptr()
+			cse.Message.Body = String("Please help")
+			_, _, err := client.Case.Create(cse)
+			_, isResponse := err.(*ErrorResponse)
+			So(isResponse, ShouldBeTrue)
+			errs, ok := AsValidationErrors(err)
+			So(ok, ShouldBeTrue)
+			So(errs, ShouldResemble, ValidationErrors{"message.to": {"invalid"}})
+		})
+	})
+}
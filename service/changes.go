@@ -0,0 +1,50 @@
+package service
+
+import (
+	. "github.com/wtlangford/go-desk/resource"
+)
+
+// changedFields returns the body of an update. For a resource decoded from a
+// response only the fields changed since are sent; a resource built locally
+// is sent whole. Read-only and create-only fields are left out, and the
+// resource is validated first. Changed enum fields holding unknown values are
+// rejected.
+func changedFields(model interface{}) (interface{}, error) {
+	err := validate(model, OperationUpdate)
+	if err != nil {
+		return nil, err
+	}
+	tracked, ok := model.(Tracked)
+	if !ok || !tracked.HasSnapshot() {
+		return model, nil
+	}
+	diff, err := tracked.Diff(model)
+	if err != nil {
+		return nil, err
+	}
+	err = CheckEnums(model, diff)
+	if err != nil {
+		return nil, err
+	}
+	StripFields(model, diff, OperationUpdate)
+	return diff, nil
+}
+
+// updated takes a new snapshot of model once an update of it succeeded, so
+// that updating it again sends only the fields changed since, and clears its
+// update actions, see ClearUpdateActions.
+func updated(model interface{}) {
+	ClearUpdateActions(model)
+	if tracked, ok := model.(Tracked); ok {
+		tracked.TakeSnapshot(model)
+	}
+}
+
+// operationFor returns the operation a request body is written for: POST
+// creates a resource, other methods update one.
+func operationFor(method string) Operation {
+	if method == "POST" {
+		return OperationCreate
+	}
+	return OperationUpdate
+}
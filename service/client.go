@@ -10,9 +10,11 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"sync"
 	"time"
 
 	desk "github.com/wtlangford/go-desk"
+	. "github.com/wtlangford/go-desk/resource"
 	"github.com/wtlangford/go-desk/service/oauth"
 )
 
@@ -30,7 +32,18 @@ type Client struct {
 	User         *UserService
 	Group        *GroupService
 	Job          *JobService
+	Insights     *InsightsService
 	MaxRetries   int
+	rateLimit    *RateLimit
+	rateLimitMu  sync.Mutex
+}
+
+// RateLimit is the request budget Desk reported with the latest response.
+// See Desk API: http://dev.desk.com/API/using-the-api/#rate-limits
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
 }
 
 func NewClient(httpClient *http.Client, endpointURL string, userEmail string, userPassword string) *Client {
@@ -57,6 +70,7 @@ func newClient(httpClient *http.Client, endpointURL string) *Client {
 	c.User = &UserService{client: c}
 	c.Group = &GroupService{client: c}
 	c.Job = &JobService{client: c}
+	c.Insights = &InsightsService{client: c}
 	c.MaxRetries = -1
 	return c
 }
@@ -83,16 +97,22 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 	u := c.BaseURL.ResolveReference(rel)
 
 	buf := new(bytes.Buffer)
-	if body != nil {
-		err := json.NewEncoder(buf).Encode(body)
+	if writable, ok := body.(Writable); ok {
+		data, err := writable.MarshalForWrite(body, operationFor(method))
 		if err != nil {
 			return nil, err
 		}
-		b, err := json.MarshalIndent(body, "", "  ")
-		if err == nil {
-			log.Printf("%s %s [request]\n%s", method, u.String(), b)
+		buf.Write(data)
+		buf.WriteByte('\n')
+	} else if body != nil {
+		err := json.NewEncoder(buf).Encode(body)
+		if err != nil {
+			return nil, err
 		}
 	}
+	if body != nil {
+		log.Printf("%s %s [request]\n%s", method, u.String(), buf.Bytes())
+	}
 
 	req, err := http.NewRequest(method, u.String(), buf)
 	if err != nil {
@@ -160,6 +180,8 @@ func (c *Client) Do(req *http.Request, v interface{}) (*http.Response, error) {
 
 	defer resp.Body.Close()
 
+	c.updateRateLimit(resp)
+
 	err = CheckResponse(resp)
 
 	if err != nil {
@@ -171,6 +193,9 @@ func (c *Client) Do(req *http.Request, v interface{}) (*http.Response, error) {
 			io.Copy(w, resp.Body)
 		} else {
 			err = json.NewDecoder(resp.Body).Decode(v)
+			if tracked, ok := v.(Tracked); ok && err == nil {
+				err = tracked.TakeSnapshot(v)
+			}
 			if err == nil {
 				b, indentErr := json.MarshalIndent(v, "", "  ")
 				if indentErr == nil {
@@ -182,10 +207,40 @@ func (c *Client) Do(req *http.Request, v interface{}) (*http.Response, error) {
 	return resp, err
 }
 
+// RateLimit returns the rate limit reported with the latest response, or
+// false if no response has reported one yet.
+func (c *Client) RateLimit() (RateLimit, bool) {
+	c.rateLimitMu.Lock()
+	defer c.rateLimitMu.Unlock()
+	if c.rateLimit == nil {
+		return RateLimit{}, false
+	}
+	return *c.rateLimit, true
+}
+
+func (c *Client) updateRateLimit(resp *http.Response) {
+	remaining, err := strconv.Atoi(resp.Header.Get("X-Rate-Limit-Remaining"))
+	if err != nil {
+		return
+	}
+	limit, _ := strconv.Atoi(resp.Header.Get("X-Rate-Limit-Limit"))
+	reset, _ := strconv.Atoi(resp.Header.Get("X-Rate-Limit-Reset"))
+	c.rateLimitMu.Lock()
+	defer c.rateLimitMu.Unlock()
+	c.rateLimit = &RateLimit{
+		Limit:     limit,
+		Remaining: remaining,
+		Reset:     time.Now().Add(time.Second * time.Duration(reset)),
+	}
+}
+
 type ErrorResponse struct {
 	Response *http.Response
 	Errors   map[string]interface{} `json:"errors"`
 	Message  string                 `json:"message"`
+	// Fields holds the errors of a 422 response by field, see
+	// AsValidationErrors.
+	Fields ValidationErrors `json:"-"`
 }
 
 func (r *ErrorResponse) Error() string {
@@ -204,5 +259,8 @@ func CheckResponse(r *http.Response) error {
 	if err == nil && data != nil {
 		json.Unmarshal(data, errorResponse)
 	}
+	if r.StatusCode == 422 && len(errorResponse.Errors) > 0 {
+		errorResponse.Fields = FlattenErrors(errorResponse.Errors)
+	}
 	return errorResponse
 }
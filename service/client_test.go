@@ -0,0 +1,52 @@
+package service
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+)
+
+// recordedRequest captures a request received by a test server, so that it
+// can be inspected after the client call returns.
+type recordedRequest struct {
+	Method   string
+	Path     string
+	RawQuery string
+	Body     string
+}
+
+// newTestClient returns a client whose requests are answered by a test server
+// with the given status and json bodies, one per request. The last body is
+// repeated once the others are used up. Received requests are appended to
+// the returned slice.
+func newTestClient(status int, bodies ...string) (*Client, *httptest.Server, *[]recordedRequest) {
+	requests := make([]recordedRequest, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, recordedRequest{
+			Method:   r.Method,
+			Path:     r.URL.Path,
+			RawQuery: r.URL.RawQuery,
+			Body:     string(body),
+		})
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if len(bodies) > 0 {
+			i := len(requests) - 1
+			if i >= len(bodies) {
+				i = len(bodies) - 1
+			}
+			fmt.Fprint(w, bodies[i])
+		}
+	}))
+	client := NewClient(nil, server.URL, "user@example.com", "secret")
+	return client, server, &requests
+}
+
+// newTestClientFunc returns a client whose requests are answered by handler.
+func newTestClientFunc(handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+	client := NewClient(nil, server.URL, "user@example.com", "secret")
+	return client, server
+}
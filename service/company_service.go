@@ -1,7 +1,6 @@
 package service
 
 import (
-	"encoding/json"
 	. "github.com/wtlangford/go-desk/resource"
 	"net/http"
 	"net/url"
@@ -12,14 +11,16 @@ type CompanyService struct {
 }
 
 // Get retrieves a company.
+// Only the given fields are returned when any are selected.
 // See Desk API: http://dev.desk.com/API/companies/#show
-func (c *CompanyService) Get(id string) (*Company, *http.Response, error) {
+func (c *CompanyService) Get(id string, fields ...CompanyField) (*Company, *http.Response, error) {
 	restful := Restful{}
 	company := NewCompany()
 	path := NewIdentityResourcePath(id, company)
 	resp, err := restful.
 		Get(path.Path()).
 		Json(company).
+		Params(fieldParams(fieldList(fields))).
 		Client(c.client).
 		Do()
 	return company, resp, err
@@ -27,7 +28,11 @@ func (c *CompanyService) Get(id string) (*Company, *http.Response, error) {
 
 // List companies with filtering and pagination.
 // See Desk API: http://dev.desk.com/API/companies/#list
-func (c *CompanyService) List(params *url.Values) (*Page, *http.Response, error) {
+func (c *CompanyService) List(opts *ListOptions) (*Page, *http.Response, error) {
+	params, err := opts.paramsFor("company")
+	if err != nil {
+		return nil, nil, err
+	}
 	restful := Restful{}
 	page := new(Page)
 	path := NewResourcePath(NewCompany())
@@ -44,10 +49,18 @@ func (c *CompanyService) List(params *url.Values) (*Page, *http.Response, error)
 	if err != nil {
 		return nil, nil, err
 	}
+	page.SetNavigator(newPageNavigator(c.client, c.unravelPage))
 	return page, resp, err
 }
 
+// ListStream lists all companies, fetching upcoming pages concurrently. The Page
+// of opts is ignored. Close the stream when done with it.
+func (c *CompanyService) ListStream(opts *ListOptions, prefetch *PrefetchOptions) *PageStream {
+	return listStream(c.client, c.List, opts, prefetch)
+}
+
 // Search companies with filtering and pagination.
+// The optional q is a raw query string appended to the encoded params.
 // See Desk API: http://dev.desk.com/API/companies/#search
 func (c *CompanyService) Search(params *url.Values, q *string) (*Page, *http.Response, error) {
 	restful := Restful{}
@@ -56,6 +69,7 @@ func (c *CompanyService) Search(params *url.Values, q *string) (*Page, *http.Res
 	resp, err := restful.
 		Get(path.Path()).
 		Json(page).
+		Query(q).
 		Params(params).
 		Client(c.client).
 		Do()
@@ -66,12 +80,26 @@ func (c *CompanyService) Search(params *url.Values, q *string) (*Page, *http.Res
 	if err != nil {
 		return nil, nil, err
 	}
+	page.SetNavigator(newPageNavigator(c.client, c.unravelPage))
 	return page, resp, err
 }
 
+// SearchWith searches companies with a typed query.
+func (c *CompanyService) SearchWith(query *CompanyQuery) (*Page, *http.Response, error) {
+	params, err := query.Params()
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.Search(params, nil)
+}
+
 // Create a company.
 // See Desk API: http://dev.desk.com/API/companies/#create
 func (c *CompanyService) Create(company *Company) (*Company, *http.Response, error) {
+	err := validate(company, OperationCreate)
+	if err != nil {
+		return nil, nil, err
+	}
 	restful := Restful{}
 	createdCompany := new(Company)
 	path := NewResourcePath(NewCompany())
@@ -84,24 +112,38 @@ func (c *CompanyService) Create(company *Company) (*Company, *http.Response, err
 	return createdCompany, resp, err
 }
 
-// Update a company.
+// Update a company. For a company fetched from Desk only the fields changed
+// since are sent, see Resource.Diff; a company built locally is sent whole.
+// Domains set with UpdateDomains are appended, removed or replaced as
+// requested.
 // See Desk API: http://dev.desk.com/API/companies/#update
 func (c *CompanyService) Update(company *Company) (*Company, *http.Response, error) {
 	restful := Restful{}
+	body, err := changedFields(company)
+	if err != nil {
+		return nil, nil, err
+	}
 	updatedCompany := new(Company)
 	path := NewIdentityResourcePath(company.GetResourceId(), company)
 	resp, err := restful.
 		Patch(path.Path()).
-		Body(company).
+		Body(body).
 		Json(updatedCompany).
 		Client(c.client).
 		Do()
+	if err == nil {
+		updated(company)
+	}
 	return updatedCompany, resp, err
 }
 
 // Cases provides a list of companies associated with a company.
 // See Desk API: http://dev.desk.com/API/companies/#list-cases
-func (c *CompanyService) Cases(id string, params *url.Values) (*Page, *http.Response, error) {
+func (c *CompanyService) Cases(id string, opts *ListOptions) (*Page, *http.Response, error) {
+	params, err := opts.paramsFor("case")
+	if err != nil {
+		return nil, nil, err
+	}
 	restful := Restful{}
 	page := new(Page)
 	path := NewIdentityResourcePath(id, NewCompany()).SetNested(NewCase())
@@ -114,16 +156,21 @@ func (c *CompanyService) Cases(id string, params *url.Values) (*Page, *http.Resp
 	if err != nil {
 		return nil, resp, err
 	}
-	err = c.unravelPage(page)
+	err = c.unravelCasePage(page)
 	if err != nil {
 		return nil, nil, err
 	}
+	page.SetNavigator(newPageNavigator(c.client, c.unravelCasePage))
 	return page, resp, err
 }
 
 // Customers provides a list of companies associated with a company.
 // See Desk API: http://dev.desk.com/API/companies/#customers-list
-func (c *CompanyService) Customers(id string, params *url.Values) (*Page, *http.Response, error) {
+func (c *CompanyService) Customers(id string, opts *ListOptions) (*Page, *http.Response, error) {
+	params, err := opts.paramsFor("customer")
+	if err != nil {
+		return nil, nil, err
+	}
 	restful := Restful{}
 	page := new(Page)
 	path := NewIdentityResourcePath(id, NewCompany()).SetNested(NewCustomer())
@@ -136,24 +183,22 @@ func (c *CompanyService) Customers(id string, params *url.Values) (*Page, *http.
 	if err != nil {
 		return nil, resp, err
 	}
-	err = c.unravelPage(page)
+	err = c.unravelCustomerPage(page)
 	if err != nil {
 		return nil, nil, err
 	}
+	page.SetNavigator(newPageNavigator(c.client, c.unravelCustomerPage))
 	return page, resp, err
 }
 
 func (c *CompanyService) unravelPage(page *Page) error {
-	companies := new([]Company)
-	err := json.Unmarshal(*page.Embedded.RawEntries, &companies)
-	if err != nil {
-		return err
-	}
-	page.Embedded.Entries = make([]interface{}, len(*companies))
-	for i, v := range *companies {
-		v.InitializeResource(v)
-		page.Embedded.Entries[i] = interface{}(v)
-	}
-	page.Embedded.RawEntries = nil
-	return err
+	return page.Embedded.DecodeEntries("company")
+}
+
+func (c *CompanyService) unravelCasePage(page *Page) error {
+	return page.Embedded.DecodeEntries("case")
+}
+
+func (c *CompanyService) unravelCustomerPage(page *Page) error {
+	return page.Embedded.DecodeEntries("customer")
 }
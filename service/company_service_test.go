@@ -0,0 +1,64 @@
+package service
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	. "github.com/wtlangford/go-desk/resource"
+	. "github.com/wtlangford/go-desk/types"
+	"testing"
+)
+
+func TestCompanyService(t *testing.T) {
+	fmt.Println("")
+	Convey("Cases", t, func() {
+		Convey("should decode the entries as cases", func() {
+			client, server, requests := newTestClient(200, `{"total_entries":1,"_embedded":{"entries":[
+				{"subject":"help","_links":{"self":{"href":"/api/v2/cases/1","class":"case"}}}]}}`)
+			defer server.Close()
+			page, _, err := client.Company.Cases("3", nil)
+			So(err, ShouldBeNil)
+			So((*requests)[0].Path, ShouldEqual, "/api/v2/companies/3/cases")
+			cse, ok := page.Embedded.Entries[0].(Case)
+			So(ok, ShouldBeTrue)
+			So(*cse.Subject, ShouldEqual, "help")
+		})
+	})
+	Convey("Customers", t, func() {
+		Convey("should decode the entries as customers", func() {
+			client, server, requests := newTestClient(200, `{"total_entries":1,"_embedded":{"entries":[
+				{"first_name":"Ada","_links":{"self":{"href":"/api/v2/customers/1","class":"customer"}}}]}}`)
+			defer server.Close()
+			page, _, err := client.Company.Customers("3", nil)
+			So(err, ShouldBeNil)
+			So((*requests)[0].Path, ShouldEqual, "/api/v2/companies/3/customers")
+			customer, ok := page.Embedded.Entries[0].(Customer)
+			So(ok, ShouldBeTrue)
+			So(*customer.FirstName, ShouldEqual, "Ada")
+		})
+	})
+	Convey("Update", t, func() {
+		Convey("should clear the domains replaced with nothing", func() {
+			client, server, requests := newTestClient(200, `{"name":"Acme",
+				"_links":{"self":{"href":"/api/v2/companies/3","class":"company"}}}`)
+			defer server.Close()
+			company, _, _ := client.Company.Get("3")
+			company.UpdateDomains(UpdateReplace)
+			_, _, err := client.Company.Update(company)
+			So(err, ShouldBeNil)
+			So((*requests)[1].Body, ShouldEqual, "{\"domains\":[],\"domains_update_action\":\"replace\"}\n")
+			So(company.DomainsUpdateAction, ShouldBeNil)
+		})
+		Convey("should not send the domains again on the next update", func() {
+			client, server, requests := newTestClient(200, `{"name":"Acme",
+				"_links":{"self":{"href":"/api/v2/companies/3","class":"company"}}}`)
+			defer server.Close()
+			company, _, _ := client.Company.Get("3")
+			company.UpdateDomains(UpdateReplace, "acme.com")
+			client.Company.Update(company)
+			company.Name = String("Acme Inc")
+			_, _, err := client.Company.Update(company)
+			So(err, ShouldBeNil)
+			So((*requests)[2].Body, ShouldEqual, "{\"name\":\"Acme Inc\"}\n")
+		})
+	})
+}
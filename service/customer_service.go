@@ -1,7 +1,6 @@
 package service
 
 import (
-	"encoding/json"
 	"net/http"
 	"net/url"
 	"strings"
@@ -14,14 +13,16 @@ type CustomerService struct {
 }
 
 // Get retrieves a customer.
+// Only the given fields are returned when any are selected.
 // See Desk API: http://dev.desk.com/API/customers/#show
-func (c *CustomerService) Get(id string) (*Customer, *http.Response, error) {
+func (c *CustomerService) Get(id string, fields ...CustomerField) (*Customer, *http.Response, error) {
 	restful := Restful{}
 	customer := NewCustomer()
 	path := NewIdentityResourcePath(id, customer)
 	resp, err := restful.
 		Get(path.Path()).
 		Json(customer).
+		Params(fieldParams(fieldList(fields))).
 		Client(c.client).
 		Do()
 	return customer, resp, err
@@ -29,7 +30,11 @@ func (c *CustomerService) Get(id string) (*Customer, *http.Response, error) {
 
 // List customers with filtering and pagination.
 // See Desk API: http://dev.desk.com/API/customers/#list
-func (c *CustomerService) List(params *url.Values) (*Page, *http.Response, error) {
+func (c *CustomerService) List(opts *ListOptions) (*Page, *http.Response, error) {
+	params, err := opts.paramsFor("customer")
+	if err != nil {
+		return nil, nil, err
+	}
 	restful := Restful{}
 	page := new(Page)
 	path := NewResourcePath(NewCustomer())
@@ -46,10 +51,18 @@ func (c *CustomerService) List(params *url.Values) (*Page, *http.Response, error
 	if err != nil {
 		return nil, nil, err
 	}
+	page.SetNavigator(newPageNavigator(c.client, c.unravelPage))
 	return page, resp, err
 }
 
+// ListStream lists all customers, fetching upcoming pages concurrently. The Page
+// of opts is ignored. Close the stream when done with it.
+func (c *CustomerService) ListStream(opts *ListOptions, prefetch *PrefetchOptions) *PageStream {
+	return listStream(c.client, c.List, opts, prefetch)
+}
+
 // Search customers with filtering and pagination.
+// The optional q is a raw query string appended to the encoded params.
 // See Desk API: http://dev.desk.com/API/customers/#search
 func (c *CustomerService) Search(params *url.Values, q *string) (*Page, *http.Response, error) {
 	restful := Restful{}
@@ -58,6 +71,7 @@ func (c *CustomerService) Search(params *url.Values, q *string) (*Page, *http.Re
 	resp, err := restful.
 		Get(path.Path()).
 		Json(page).
+		Query(q).
 		Params(params).
 		Client(c.client).
 		Do()
@@ -68,12 +82,26 @@ func (c *CustomerService) Search(params *url.Values, q *string) (*Page, *http.Re
 	if err != nil {
 		return nil, nil, err
 	}
+	page.SetNavigator(newPageNavigator(c.client, c.unravelPage))
 	return page, resp, err
 }
 
+// SearchWith searches customers with a typed query.
+func (c *CustomerService) SearchWith(query *CustomerQuery) (*Page, *http.Response, error) {
+	params, err := query.Params()
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.Search(params, nil)
+}
+
 // Create a customer.
 // See Desk API: http://dev.desk.com/API/customers/#create
 func (c *CustomerService) Create(customer *Customer) (*Customer, *http.Response, error) {
+	err := validate(customer, OperationCreate)
+	if err != nil {
+		return nil, nil, err
+	}
 	restful := Restful{}
 	createdCustomer := new(Customer)
 	path := NewResourcePath(NewCustomer())
@@ -86,18 +114,28 @@ func (c *CustomerService) Create(customer *Customer) (*Customer, *http.Response,
 	return createdCustomer, resp, err
 }
 
-// Update a customer.
+// Update a customer. For a customer fetched from Desk only the fields
+// changed since are sent, see Resource.Diff; a customer built locally is
+// sent whole. Emails, phone numbers and addresses set with UpdateEmails and
+// its siblings are appended, removed or replaced as requested.
 // See Desk API: http://dev.desk.com/API/customers/#update
 func (c *CustomerService) Update(customer *Customer) (*Customer, *http.Response, error) {
 	restful := Restful{}
+	body, err := changedFields(customer)
+	if err != nil {
+		return nil, nil, err
+	}
 	updatedCustomer := new(Customer)
 	path := NewIdentityResourcePath(customer.GetResourceId(), customer)
 	resp, err := restful.
 		Patch(path.Path()).
-		Body(customer).
+		Body(body).
 		Json(updatedCustomer).
 		Client(c.client).
 		Do()
+	if err == nil {
+		updated(customer)
+	}
 	return updatedCustomer, resp, err
 }
 
@@ -112,7 +150,8 @@ type MergeOverrides struct {
 	Hal
 }
 
-// Merge customers.
+// Merge customers. Merging runs as a job; the returned job id can be passed
+// to JobService.Wait.
 // See Desk API: http://dev.desk.com/API/customers/#merge
 func (c *CustomerService) Merge(customer *Customer, overrides *MergeOverrides, customers ...*Customer) (string, *http.Response, error) {
 	for _, c := range customers {
@@ -141,7 +180,11 @@ func (c *CustomerService) Merge(customer *Customer, overrides *MergeOverrides, c
 
 // Cases provides a list of cases associated with a customer.
 // See Desk API: http://dev.desk.com/API/customers/#list-cases
-func (c *CustomerService) Cases(id string, params *url.Values) (*Page, *http.Response, error) {
+func (c *CustomerService) Cases(id string, opts *ListOptions) (*Page, *http.Response, error) {
+	params, err := opts.paramsFor("case")
+	if err != nil {
+		return nil, nil, err
+	}
 	restful := Restful{}
 	page := new(Page)
 	path := NewIdentityResourcePath(id, NewCustomer()).SetNested(NewCase())
@@ -154,24 +197,38 @@ func (c *CustomerService) Cases(id string, params *url.Values) (*Page, *http.Res
 	if err != nil {
 		return nil, resp, err
 	}
-	err = c.unravelPage(page)
+	err = c.unravelCasePage(page)
 	if err != nil {
 		return nil, nil, err
 	}
+	page.SetNavigator(newPageNavigator(c.client, c.unravelCasePage))
 	return page, resp, err
 }
 
-func (c *CustomerService) unravelPage(page *Page) error {
-	customers := new([]Customer)
-	err := json.Unmarshal(*page.Embedded.RawEntries, &customers)
+// CreateCase creates a case for a customer, through the customer's cases
+// endpoint, so the case does not need a customer link.
+// See Desk API: http://dev.desk.com/API/customers/#create-case
+func (c *CustomerService) CreateCase(id string, cse *Case) (*Case, *http.Response, error) {
+	err := validate(cse, OperationCreate, "_links.customer")
 	if err != nil {
-		return err
-	}
-	page.Embedded.Entries = make([]interface{}, len(*customers))
-	for i, v := range *customers {
-		v.InitializeResource(v)
-		page.Embedded.Entries[i] = interface{}(v)
+		return nil, nil, err
 	}
-	page.Embedded.RawEntries = nil
-	return err
+	restful := Restful{}
+	createdCase := NewCase()
+	path := NewIdentityResourcePath(id, NewCustomer()).SetNested(NewCase())
+	resp, err := restful.
+		Post(path.Path()).
+		Body(cse).
+		Json(createdCase).
+		Client(c.client).
+		Do()
+	return createdCase, resp, err
+}
+
+func (c *CustomerService) unravelPage(page *Page) error {
+	return page.Embedded.DecodeEntries("customer")
+}
+
+func (c *CustomerService) unravelCasePage(page *Page) error {
+	return page.Embedded.DecodeEntries("case")
 }
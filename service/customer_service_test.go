@@ -0,0 +1,163 @@
+package service
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	. "github.com/wtlangford/go-desk/resource"
+	. "github.com/wtlangford/go-desk/types"
+	"testing"
+)
+
+func TestCustomerService(t *testing.T) {
+	fmt.Println("")
+	Convey("CreateCase", t, func() {
+		Convey("should post to the customer's cases", func() {
+			client, server, requests := newTestClient(201,
+				`{"subject":"help","_links":{"self":{"href":"/api/v2/cases/9","class":"case"}}}`)
+			defer server.Close()
+			cse := NewCase()
+			cse.Subject = String("help")
+			cse.Message = NewMessage()
+			cse.Message.Direction = DirectionIn.Ptr()
+			cse.Message.Body = String("Please help")
+			created, _, err := client.Customer.CreateCase("5", cse)
+			So(err, ShouldBeNil)
+			So((*requests)[0].Method, ShouldEqual, "POST")
+			So((*requests)[0].Path, ShouldEqual, "/api/v2/customers/5/cases")
+			So((*requests)[0].Body, ShouldEqual, "{\"subject\":\"help\",\"message\":{\"direction\":\"in\",\"body\":\"Please help\"}}\n")
+			So(created.GetResourceId(), ShouldEqual, "9")
+		})
+	})
+	Convey("Cases", t, func() {
+		Convey("should decode the entries as cases, on every page", func() {
+			first := `{"page":1,"total_entries":2,"_links":{
+				"next":{"href":"/api/v2/customers/5/cases?page=2&per_page=1","class":"page"}},
+				"_embedded":{"entries":[{"subject":"help","_links":{"self":{"href":"/api/v2/cases/1","class":"case"}}}]}}`
+			second := `{"page":2,"total_entries":2,
+				"_embedded":{"entries":[{"subject":"more help","_links":{"self":{"href":"/api/v2/cases/2","class":"case"}}}]}}`
+			client, server, requests := newTestClient(200, first, second)
+			defer server.Close()
+			page, _, err := client.Customer.Cases("5", nil)
+			So(err, ShouldBeNil)
+			So((*requests)[0].Path, ShouldEqual, "/api/v2/customers/5/cases")
+			cse, ok := page.Embedded.Entries[0].(Case)
+			So(ok, ShouldBeTrue)
+			So(*cse.Subject, ShouldEqual, "help")
+			next, _, err := page.Next()
+			So(err, ShouldBeNil)
+			cse, ok = next.Embedded.Entries[0].(Case)
+			So(ok, ShouldBeTrue)
+			So(*cse.Subject, ShouldEqual, "more help")
+		})
+	})
+}
+
+func TestCustomerServiceUpdate(t *testing.T) {
+	fmt.Println("")
+	Convey("Update", t, func() {
+		customerJson := `{"first_name":"Ada","last_name":"Lovelace","created_at":"2015-01-02T03:04:05Z",
+			"_links":{"self":{"href":"/api/v2/customers/5","class":"customer"}}}`
+		Convey("should send only the changed fields of a fetched customer", func() {
+			client, server, requests := newTestClient(200, customerJson)
+			defer server.Close()
+			customer, _, err := client.Customer.Get("5")
+			So(err, ShouldBeNil)
+			customer.Title = String("Countess")
+			_, _, err = client.Customer.Update(customer)
+			So(err, ShouldBeNil)
+			So((*requests)[1].Method, ShouldEqual, "PATCH")
+			So((*requests)[1].Path, ShouldEqual, "/api/v2/customers/5")
+			So((*requests)[1].Body, ShouldEqual, "{\"title\":\"Countess\"}\n")
+		})
+		Convey("should send a customer built locally whole", func() {
+			client, server, requests := newTestClient(200, customerJson)
+			defer server.Close()
+			customer := NewCustomer()
+			customer.SetResourceId("5")
+			customer.FirstName = String("Ada")
+			client.Customer.Update(customer)
+			So((*requests)[0].Body, ShouldContainSubstring, "\"first_name\":\"Ada\"")
+		})
+		Convey("should send the update action of contact points", func() {
+			client, server, requests := newTestClient(200, customerJson)
+			defer server.Close()
+			customer, _, _ := client.Customer.Get("5")
+			customer.UpdatePhoneNumbers(UpdateAppend, ContactPoint{Type: ContactMobile, Value: "555-0100"})
+			_, _, err := client.Customer.Update(customer)
+			So(err, ShouldBeNil)
+			So((*requests)[1].Body, ShouldEqual, "{\"phone_numbers\":[{\"type\":\"mobile\",\"value\":\"555-0100\"}],\"phone_numbers_update_action\":\"append\"}\n")
+		})
+		Convey("should clear a list replaced with nothing", func() {
+			client, server, requests := newTestClient(200, customerJson)
+			defer server.Close()
+			customer, _, _ := client.Customer.Get("5")
+			customer.UpdateEmails(UpdateReplace)
+			_, _, err := client.Customer.Update(customer)
+			So(err, ShouldBeNil)
+			So((*requests)[1].Body, ShouldEqual, "{\"emails\":[],\"emails_update_action\":\"replace\"}\n")
+
+			local := NewCustomer()
+			local.SetResourceId("5")
+			local.UpdateAddresses(UpdateReplace)
+			_, _, err = client.Customer.Update(local)
+			So(err, ShouldBeNil)
+			So((*requests)[2].Body, ShouldContainSubstring, "\"addresses\":[],\"addresses_update_action\":\"replace\"")
+		})
+		Convey("should send an update action once", func() {
+			client, server, requests := newTestClient(200, customerJson)
+			defer server.Close()
+			customer, _, _ := client.Customer.Get("5")
+			customer.UpdateEmails(UpdateRemove, ContactPoint{Value: "ada@example.com"})
+			_, _, err := client.Customer.Update(customer)
+			So(err, ShouldBeNil)
+			So(customer.EmailsUpdateAction, ShouldBeNil)
+			customer.Title = String("Countess")
+			_, _, err = client.Customer.Update(customer)
+			So(err, ShouldBeNil)
+			So((*requests)[2].Body, ShouldNotContainSubstring, "update_action")
+		})
+		Convey("should reject unknown update actions", func() {
+			client, server, requests := newTestClient(200, customerJson)
+			defer server.Close()
+			customer, _, _ := client.Customer.Get("5")
+			customer.UpdateEmails(UpdateAction("merge"), ContactPoint{Value: "ada@example.com"})
+			_, _, err := client.Customer.Update(customer)
+			So(err, ShouldNotBeNil)
+			So(len(*requests), ShouldEqual, 1)
+		})
+	})
+}
+
+func TestCustomerServiceExtras(t *testing.T) {
+	fmt.Println("")
+	Convey("Create", t, func() {
+		Convey("should send the extras set by the caller only", func() {
+			client, server, requests := newTestClient(201, `{}`)
+			defer server.Close()
+			customer := NewCustomer()
+			customer.UnmarshalJSON([]byte(`{"first_name":"Ada","last_seen_at":"2015-01-02T03:04:05Z"}`))
+			customer.SetExtra("nickname", "Countess")
+			_, _, err := client.Customer.Create(customer)
+			So(err, ShouldBeNil)
+			So((*requests)[0].Body, ShouldEqual, "{\"first_name\":\"Ada\",\"nickname\":\"Countess\"}\n")
+		})
+		Convey("should not send update actions", func() {
+			client, server, requests := newTestClient(201, `{}`)
+			defer server.Close()
+			customer := NewCustomer()
+			customer.UpdateEmails(UpdateReplace, ContactPoint{Type: ContactWork, Value: "ada@example.com"})
+			_, _, err := client.Customer.Create(customer)
+			So(err, ShouldBeNil)
+			So((*requests)[0].Body, ShouldEqual, "{\"emails\":[{\"type\":\"work\",\"value\":\"ada@example.com\"}]}\n")
+		})
+		Convey("should not send read-only fields", func() {
+			client, server, requests := newTestClient(201, `{}`)
+			defer server.Close()
+			customer := NewCustomer()
+			customer.UnmarshalJSON([]byte(`{"first_name":"Ada","avatar":"https://example.com/ada.png","created_at":"2015-01-02T03:04:05Z"}`))
+			_, _, err := client.Customer.Create(customer)
+			So(err, ShouldBeNil)
+			So((*requests)[0].Body, ShouldEqual, "{\"first_name\":\"Ada\"}\n")
+		})
+	})
+}
@@ -26,6 +26,10 @@ func (c *DraftService) Get(id string) (*Draft, *http.Response, error) {
 // Create a draft.
 // See Desk API: http://dev.desk.com/API/cases/#drafts-create
 func (c *DraftService) Create(id string, draft *Draft) (*Draft, *http.Response, error) {
+	err := validate(draft, OperationCreate)
+	if err != nil {
+		return nil, nil, err
+	}
 	restful := Restful{}
 	createdDraft := NewDraft()
 	path := NewIdentityResourcePath(id, NewCase()).SetAction("replies").SetNested(draft)
@@ -42,13 +46,20 @@ func (c *DraftService) Create(id string, draft *Draft) (*Draft, *http.Response,
 // See Desk API: http://dev.desk.com/API/replies/#update
 func (c *DraftService) Update(id string, draft *Draft) (*Draft, *http.Response, error) {
 	restful := Restful{}
+	body, err := changedFields(draft)
+	if err != nil {
+		return nil, nil, err
+	}
 	updatedDraft := NewDraft()
 	casesPath := NewIdentityResourcePath(id, NewCase()).SetAction("replies").SetNested(NewDraft())
 	resp, err := restful.
 		Patch(casesPath.Path()).
-		Body(draft).
+		Body(body).
 		Json(updatedDraft).
 		Client(c.client).
 		Do()
+	if err == nil {
+		updated(draft)
+	}
 	return updatedDraft, resp, err
 }
@@ -0,0 +1,23 @@
+package service
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	. "github.com/wtlangford/go-desk/resource"
+	"testing"
+)
+
+func TestDraftService(t *testing.T) {
+	fmt.Println("")
+	Convey("Create", t, func() {
+		Convey("should not send a draft without a body", func() {
+			client, server, requests := newTestClient(201, `{"status":"draft","_links":{"self":{"href":"/api/v2/cases/1/replies/5","class":"reply"}}}`)
+			defer server.Close()
+			_, _, err := client.Case.Draft.Create("1", NewDraft())
+			errs, ok := AsValidationErrors(err)
+			So(ok, ShouldBeTrue)
+			So(errs.Has("body"), ShouldBeTrue)
+			So(len(*requests), ShouldEqual, 0)
+		})
+	})
+}
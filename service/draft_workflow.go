@@ -0,0 +1,225 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	. "github.com/wtlangford/go-desk/resource"
+	. "github.com/wtlangford/go-desk/types"
+	"io"
+	"net/http"
+)
+
+// ErrDraftWorkflowDone is returned when a draft workflow is used after its
+// draft was sent or discarded.
+var ErrDraftWorkflowDone = errors.New("desk: draft was already sent or discarded")
+
+// DraftConflictError is returned by a DraftWorkflow when the draft of the
+// case changed since the workflow last saw it, for example because another
+// agent edited, sent or discarded it. Actual is nil if the draft no longer
+// exists.
+type DraftConflictError struct {
+	CaseId   string
+	Expected *Draft
+	Actual   *Draft
+}
+
+func (e *DraftConflictError) Error() string {
+	if e.Actual == nil {
+		return fmt.Sprintf("desk: draft of case %v no longer exists", e.CaseId)
+	}
+	return fmt.Sprintf("desk: draft of case %v was changed by someone else", e.CaseId)
+}
+
+// BodyTemplate renders a draft body. Both text/template and html/template
+// templates can be used.
+type BodyTemplate interface {
+	Execute(w io.Writer, data interface{}) error
+}
+
+// DraftWorkflow takes the draft reply of a case from creation to being sent
+// or discarded. Before every change the workflow checks that the draft on
+// Desk is still the one it created or last changed, and returns a
+// *DraftConflictError if it is not.
+type DraftWorkflow struct {
+	CaseId      string
+	Draft       *Draft
+	Attachments []*Attachment
+	service     *DraftService
+	done        bool
+}
+
+// Start creates the draft reply of a case and returns a workflow for it.
+// draft is left as is; the workflow works on the draft Desk returns.
+func (c *DraftService) Start(caseId string, draft *Draft) (*DraftWorkflow, *http.Response, error) {
+	if draft.Status == nil {
+		withStatus, err := draft.Copy()
+		if err != nil {
+			return nil, nil, err
+		}
+		withStatus.Status = ReplyStatusDraft.Ptr()
+		draft = withStatus
+	}
+	createdDraft, resp, err := c.Create(caseId, draft)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &DraftWorkflow{CaseId: caseId, Draft: createdDraft, service: c}, resp, nil
+}
+
+// Resume returns a workflow for the existing draft reply of a case.
+func (c *DraftService) Resume(caseId string) (*DraftWorkflow, *http.Response, error) {
+	draft, resp, err := c.Get(caseId)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &DraftWorkflow{CaseId: caseId, Draft: draft, service: c}, resp, nil
+}
+
+// Attach uploads a file to the draft.
+func (w *DraftWorkflow) Attach(attach *Attachment) (*Attachment, *http.Response, error) {
+	if resp, err := w.verify(); err != nil {
+		return nil, resp, err
+	}
+	attachments := AttachmentService{client: w.service.client}
+	createdAttachment, resp, err := attachments.CreateForReply(w.CaseId, w.Draft.GetResourceId(), attach)
+	if err != nil {
+		return nil, resp, err
+	}
+	w.Attachments = append(w.Attachments, createdAttachment)
+	// adding an attachment touches the draft, so pick up its new state unless
+	// its content changed as well, which only someone else can have done
+	draft, resp, err := w.service.Get(w.CaseId)
+	if err != nil {
+		return createdAttachment, resp, err
+	}
+	if draftContentChanged(w.Draft, draft) {
+		return createdAttachment, resp, &DraftConflictError{CaseId: w.CaseId, Expected: w.Draft, Actual: draft}
+	}
+	w.Draft = draft
+	return createdAttachment, resp, nil
+}
+
+// SetBody replaces the body of the draft.
+func (w *DraftWorkflow) SetBody(body string) (*Draft, *http.Response, error) {
+	changes := NewDraft()
+	changes.Body = String(body)
+	return w.update(changes)
+}
+
+// Render executes tmpl with data and uses the result as the body of the
+// draft.
+func (w *DraftWorkflow) Render(tmpl BodyTemplate, data interface{}) (*Draft, *http.Response, error) {
+	buf := new(bytes.Buffer)
+	if err := tmpl.Execute(buf, data); err != nil {
+		return nil, nil, err
+	}
+	return w.SetBody(buf.String())
+}
+
+// Send sends the draft. Desk moves the reply from the draft status to
+// pending, and to sent once it has been delivered.
+func (w *DraftWorkflow) Send() (*Reply, *http.Response, error) {
+	if resp, err := w.verify(); err != nil {
+		return nil, resp, err
+	}
+	restful := Restful{}
+	sentReply := NewReply()
+	changes := NewDraft()
+	changes.Status = ReplyStatusPending.Ptr()
+	path := NewIdentityResourcePath(w.CaseId, NewCase()).SetAction("replies").SetNested(NewDraft())
+	resp, err := restful.
+		Patch(path.Path()).
+		Body(changes).
+		Json(sentReply).
+		Client(w.service.client).
+		Do()
+	if err != nil {
+		return nil, resp, err
+	}
+	if sentReply.Status != nil && *sentReply.Status == ReplyStatusDraft {
+		return sentReply, resp, fmt.Errorf("desk: draft of case %v was not sent", w.CaseId)
+	}
+	w.done = true
+	return sentReply, resp, nil
+}
+
+// Discard deletes the draft without sending it.
+func (w *DraftWorkflow) Discard() (*http.Response, error) {
+	if resp, err := w.verify(); err != nil {
+		return resp, err
+	}
+	replies := ReplyService{client: w.service.client}
+	resp, err := replies.Delete(w.CaseId, w.Draft.GetResourceId())
+	if err == nil {
+		w.done = true
+	}
+	return resp, err
+}
+
+func (w *DraftWorkflow) update(changes *Draft) (*Draft, *http.Response, error) {
+	if resp, err := w.verify(); err != nil {
+		return nil, resp, err
+	}
+	updatedDraft, resp, err := w.service.Update(w.CaseId, changes)
+	if err != nil {
+		return nil, resp, err
+	}
+	w.Draft = updatedDraft
+	return updatedDraft, resp, nil
+}
+
+// verify checks that the draft on Desk is still the one the workflow knows.
+func (w *DraftWorkflow) verify() (*http.Response, error) {
+	if w.done {
+		return nil, ErrDraftWorkflowDone
+	}
+	current, resp, err := w.service.Get(w.CaseId)
+	if err != nil {
+		if errResp, ok := err.(*ErrorResponse); ok && errResp.Response.StatusCode == http.StatusNotFound {
+			return resp, &DraftConflictError{CaseId: w.CaseId, Expected: w.Draft}
+		}
+		return resp, err
+	}
+	if draftChanged(w.Draft, current) {
+		return resp, &DraftConflictError{CaseId: w.CaseId, Expected: w.Draft, Actual: current}
+	}
+	return resp, nil
+}
+
+func draftChanged(expected *Draft, actual *Draft) bool {
+	if expected.GetResourceId() != actual.GetResourceId() {
+		return true
+	}
+	if actual.Status != nil && *actual.Status != ReplyStatusDraft {
+		return true
+	}
+	if expected.UpdatedAt != nil && actual.UpdatedAt != nil {
+		return !expected.UpdatedAt.Equal(*actual.UpdatedAt)
+	}
+	return false
+}
+
+// draftContentChanged reports whether actual is another draft than expected,
+// or has another content, whenever it was updated.
+func draftContentChanged(expected *Draft, actual *Draft) bool {
+	if expected.GetResourceId() != actual.GetResourceId() {
+		return true
+	}
+	if actual.Status != nil && *actual.Status != ReplyStatusDraft {
+		return true
+	}
+	return !sameString(expected.Body, actual.Body) ||
+		!sameString(expected.Subject, actual.Subject) ||
+		!sameString(expected.To, actual.To) ||
+		!sameString(expected.From, actual.From) ||
+		!sameString(expected.Cc, actual.Cc) ||
+		!sameString(expected.Bcc, actual.Bcc)
+}
+
+func sameString(a *string, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	. "github.com/wtlangford/go-desk/resource"
+	. "github.com/wtlangford/go-desk/types"
+	"testing"
+	"text/template"
+)
+
+func TestDraftWorkflow(t *testing.T) {
+	fmt.Println("")
+	draft := func(status string, updatedAt string) string {
+		return fmt.Sprintf(`{"status":%q,"updated_at":%q,"_links":{"self":{"href":"/api/v2/cases/1/replies/5","class":"reply"}}}`, status, updatedAt)
+	}
+	original := draft("draft", "2015-03-01T10:00:00Z")
+	rendered := draft("draft", "2015-03-01T10:05:00Z")
+	changed := draft("draft", "2015-03-01T11:00:00Z")
+	sent := draft("pending", "2015-03-01T10:06:00Z")
+	newDraft := func() *Draft {
+		d := NewDraft()
+		d.Body = String("hello")
+		return d
+	}
+
+	Convey("Start", t, func() {
+		Convey("should create the draft with the draft status", func() {
+			client, server, requests := newTestClient(201, original)
+			defer server.Close()
+			workflow, _, err := client.Case.Draft.Start("1", newDraft())
+			So(err, ShouldBeNil)
+			So((*requests)[0].Method, ShouldEqual, "POST")
+			So((*requests)[0].Path, ShouldEqual, "/api/v2/cases/1/replies/draft")
+			So((*requests)[0].Body, ShouldContainSubstring, `"status":"draft"`)
+			So(workflow.Draft.GetResourceId(), ShouldEqual, "5")
+		})
+		Convey("should leave the draft passed in as is", func() {
+			client, server, requests := newTestClient(201, original, original, rendered)
+			defer server.Close()
+			d := newDraft()
+			d.AddHrefLink("outbound_mailbox", "/api/v2/mailboxes/outbound/1")
+			workflow, _, err := client.Case.Draft.Start("1", d)
+			So(err, ShouldBeNil)
+			So((*requests)[0].Body, ShouldContainSubstring, "outbound_mailbox")
+			workflow.Draft.AddHrefLink("outbound_mailbox", "/api/v2/mailboxes/outbound/2")
+			_, _, err = workflow.SetBody("changed")
+			So(err, ShouldBeNil)
+			So(d.Status, ShouldBeNil)
+			So(*d.Body, ShouldEqual, "hello")
+			So(d.GetHrefLink("outbound_mailbox"), ShouldEqual, "/api/v2/mailboxes/outbound/1")
+		})
+	})
+	Convey("Render and Send", t, func() {
+		Convey("should render the body and send the draft", func() {
+			client, server, requests := newTestClient(200, original, original, rendered, rendered, sent)
+			defer server.Close()
+			workflow, _, err := client.Case.Draft.Start("1", newDraft())
+			So(err, ShouldBeNil)
+			tmpl := template.Must(template.New("body").Parse("Hi {{.}}, your case is resolved."))
+			_, _, err = workflow.Render(tmpl, "Jane")
+			So(err, ShouldBeNil)
+			So((*requests)[2].Method, ShouldEqual, "PATCH")
+			So((*requests)[2].Body, ShouldEqual, "{\"body\":\"Hi Jane, your case is resolved.\"}\n")
+			reply, _, err := workflow.Send()
+			So(err, ShouldBeNil)
+			So(*reply.Status, ShouldEqual, ReplyStatusPending)
+			So((*requests)[4].Method, ShouldEqual, "PATCH")
+			So((*requests)[4].Body, ShouldEqual, "{\"status\":\"pending\"}\n")
+			_, err = workflow.Discard()
+			So(err, ShouldEqual, ErrDraftWorkflowDone)
+		})
+	})
+	Convey("Attach", t, func() {
+		Convey("should attach the file to the draft reply", func() {
+			attachment := `{"file_name":"test.png","_links":{"self":{"href":"/api/v2/cases/1/replies/5/attachments/9","class":"attachment"}}}`
+			client, server, requests := newTestClient(200, original, original, attachment, rendered)
+			defer server.Close()
+			workflow, _, _ := client.Case.Draft.Start("1", newDraft())
+			attach := newAttachment()
+			created, _, err := workflow.Attach(attach)
+			So(err, ShouldBeNil)
+			So(created.GetResourceId(), ShouldEqual, "9")
+			So((*requests)[2].Path, ShouldEqual, "/api/v2/cases/1/replies/5/attachments")
+			So(len(workflow.Attachments), ShouldEqual, 1)
+			So(workflow.Draft.UpdatedAt.Minute(), ShouldEqual, 5)
+		})
+	})
+	Convey("conflicts", t, func() {
+		Convey("should report a draft changed by someone else", func() {
+			client, server, requests := newTestClient(200, original, changed)
+			defer server.Close()
+			workflow, _, _ := client.Case.Draft.Start("1", newDraft())
+			_, _, err := workflow.Send()
+			So(err, ShouldHaveSameTypeAs, &DraftConflictError{})
+			So(err.(*DraftConflictError).Actual.UpdatedAt.Hour(), ShouldEqual, 11)
+			So(len(*requests), ShouldEqual, 2)
+		})
+		Convey("should report a draft changed by someone else while attaching", func() {
+			attachment := `{"file_name":"test.png","_links":{"self":{"href":"/api/v2/cases/1/replies/5/attachments/9","class":"attachment"}}}`
+			edited := `{"status":"draft","body":"edited","updated_at":"2015-03-01T10:05:00Z","_links":{"self":{"href":"/api/v2/cases/1/replies/5","class":"reply"}}}`
+			client, server, _ := newTestClient(200, original, original, attachment, edited)
+			defer server.Close()
+			workflow, _, _ := client.Case.Draft.Start("1", newDraft())
+			created, _, err := workflow.Attach(newAttachment())
+			So(err, ShouldHaveSameTypeAs, &DraftConflictError{})
+			So(created.GetResourceId(), ShouldEqual, "9")
+			So(workflow.Draft.UpdatedAt.Minute(), ShouldEqual, 0)
+		})
+		Convey("should report a draft sent by someone else", func() {
+			client, server, _ := newTestClient(200, original, sent)
+			defer server.Close()
+			workflow, _, _ := client.Case.Draft.Start("1", newDraft())
+			_, err := workflow.Discard()
+			So(err, ShouldHaveSameTypeAs, &DraftConflictError{})
+		})
+		Convey("should report a discarded draft", func() {
+			client, server, _ := newTestClient(404, `{"message":"Resource Not Found"}`)
+			defer server.Close()
+			workflow := &DraftWorkflow{CaseId: "1", Draft: NewDraft(), service: client.Case.Draft}
+			_, _, err := workflow.SetBody("hello")
+			So(err, ShouldHaveSameTypeAs, &DraftConflictError{})
+			So(err.(*DraftConflictError).Actual, ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Field selects a single attribute of a resource for Desk's fields
+// parameter. Attributes that are not selected are left out of the response
+// and stay nil when decoded. Links are always returned.
+// See Desk API: http://dev.desk.com/API/using-the-api/#fields
+type Field interface {
+	FieldName() string
+	// FieldClass returns the class of the resources the field selects an
+	// attribute of, e.g. "case". List methods reject the fields of other
+	// classes.
+	FieldClass() string
+}
+
+// CaseField selects an attribute of a case.
+type CaseField string
+
+const (
+	CaseFieldId              CaseField = "id"
+	CaseFieldExternalId      CaseField = "external_id"
+	CaseFieldType            CaseField = "type"
+	CaseFieldStatus          CaseField = "status"
+	CaseFieldDescription     CaseField = "description"
+	CaseFieldSubject         CaseField = "subject"
+	CaseFieldBlurb           CaseField = "blurb"
+	CaseFieldLanguage        CaseField = "language"
+	CaseFieldPriority        CaseField = "priority"
+	CaseFieldLabels          CaseField = "labels"
+	CaseFieldLabelIds        CaseField = "label_ids"
+	CaseFieldCustomFields    CaseField = "custom_fields"
+	CaseFieldLockedUntil     CaseField = "locked_until"
+	CaseFieldCreatedAt       CaseField = "created_at"
+	CaseFieldUpdatedAt       CaseField = "updated_at"
+	CaseFieldChangedAt       CaseField = "changed_at"
+	CaseFieldReceivedAt      CaseField = "received_at"
+	CaseFieldActiveAt        CaseField = "active_at"
+	CaseFieldOpenedAt        CaseField = "opened_at"
+	CaseFieldFirstOpenedAt   CaseField = "first_opened_at"
+	CaseFieldResolvedAt      CaseField = "resolved_at"
+	CaseFieldFirstResolvedAt CaseField = "first_resolved_at"
+)
+
+func (f CaseField) FieldName() string {
+	return string(f)
+}
+
+func (f CaseField) FieldClass() string {
+	return "case"
+}
+
+// CustomerField selects an attribute of a customer.
+type CustomerField string
+
+const (
+	CustomerFieldId           CustomerField = "id"
+	CustomerFieldExternalId   CustomerField = "external_id"
+	CustomerFieldFirstName    CustomerField = "first_name"
+	CustomerFieldLastName     CustomerField = "last_name"
+	CustomerFieldCompany      CustomerField = "company"
+	CustomerFieldTitle        CustomerField = "title"
+	CustomerFieldAvatar       CustomerField = "avatar"
+	CustomerFieldBackground   CustomerField = "background"
+	CustomerFieldLanguage     CustomerField = "language"
+	CustomerFieldLockedUntil  CustomerField = "locked_until"
+	CustomerFieldCreatedAt    CustomerField = "created_at"
+	CustomerFieldUpdatedAt    CustomerField = "updated_at"
+	CustomerFieldCustomFields CustomerField = "custom_fields"
+	CustomerFieldEmails       CustomerField = "emails"
+	CustomerFieldPhoneNumbers CustomerField = "phone_numbers"
+	CustomerFieldAddresses    CustomerField = "addresses"
+)
+
+func (f CustomerField) FieldName() string {
+	return string(f)
+}
+
+func (f CustomerField) FieldClass() string {
+	return "customer"
+}
+
+// CompanyField selects an attribute of a company.
+type CompanyField string
+
+const (
+	CompanyFieldId           CompanyField = "id"
+	CompanyFieldExternalId   CompanyField = "external_id"
+	CompanyFieldName         CompanyField = "name"
+	CompanyFieldDomains      CompanyField = "domains"
+	CompanyFieldCreatedAt    CompanyField = "created_at"
+	CompanyFieldUpdatedAt    CompanyField = "updated_at"
+	CompanyFieldCustomFields CompanyField = "custom_fields"
+)
+
+func (f CompanyField) FieldName() string {
+	return string(f)
+}
+
+func (f CompanyField) FieldClass() string {
+	return "company"
+}
+
+// UserField selects an attribute of a user.
+type UserField string
+
+const (
+	UserFieldId             UserField = "id"
+	UserFieldName           UserField = "name"
+	UserFieldPublicName     UserField = "public_name"
+	UserFieldEmail          UserField = "email"
+	UserFieldEmailVerified  UserField = "email_verified"
+	UserFieldAvailable      UserField = "available"
+	UserFieldAvatar         UserField = "avatar"
+	UserFieldLevel          UserField = "level"
+	UserFieldCreatedAt      UserField = "created_at"
+	UserFieldUpdatedAt      UserField = "updated_at"
+	UserFieldCurrentLoginAt UserField = "current_login_at"
+	UserFieldLastLoginAt    UserField = "last_login_at"
+)
+
+func (f UserField) FieldName() string {
+	return string(f)
+}
+
+func (f UserField) FieldClass() string {
+	return "user"
+}
+
+// GroupField selects an attribute of a group.
+type GroupField string
+
+const (
+	GroupFieldId   GroupField = "id"
+	GroupFieldName GroupField = "name"
+)
+
+func (f GroupField) FieldName() string {
+	return string(f)
+}
+
+func (f GroupField) FieldClass() string {
+	return "group"
+}
+
+// ReplyField selects an attribute of a reply.
+type ReplyField string
+
+const (
+	ReplyFieldId        ReplyField = "id"
+	ReplyFieldDirection ReplyField = "direction"
+	ReplyFieldBody      ReplyField = "body"
+	ReplyFieldStatus    ReplyField = "status"
+	ReplyFieldSubject   ReplyField = "subject"
+	ReplyFieldTo        ReplyField = "to"
+	ReplyFieldFrom      ReplyField = "from"
+	ReplyFieldType      ReplyField = "type"
+	ReplyFieldEnteredAt ReplyField = "entered_at"
+	ReplyFieldCreatedAt ReplyField = "created_at"
+	ReplyFieldUpdatedAt ReplyField = "updated_at"
+)
+
+func (f ReplyField) FieldName() string {
+	return string(f)
+}
+
+func (f ReplyField) FieldClass() string {
+	return "reply"
+}
+
+// NoteField selects an attribute of a note.
+type NoteField string
+
+const (
+	NoteFieldId        NoteField = "id"
+	NoteFieldBody      NoteField = "body"
+	NoteFieldErasedAt  NoteField = "erased_at"
+	NoteFieldCreatedAt NoteField = "created_at"
+	NoteFieldUpdatedAt NoteField = "updated_at"
+)
+
+func (f NoteField) FieldName() string {
+	return string(f)
+}
+
+func (f NoteField) FieldClass() string {
+	return "note"
+}
+
+// joinFields encodes fields as the comma separated value of the fields
+// parameter.
+func joinFields(fields []Field) string {
+	names := make([]string, 0, len(fields))
+	for _, field := range fields {
+		names = append(names, field.FieldName())
+	}
+	return strings.Join(names, ",")
+}
+
+// fieldParams returns the parameters selecting fields, or nil when no field
+// is selected.
+func fieldParams(fields []Field) *url.Values {
+	if len(fields) == 0 {
+		return nil
+	}
+	return &url.Values{"fields": {joinFields(fields)}}
+}
+
+// fieldList converts a slice of typed fields, such as []CaseField, to
+// []Field.
+func fieldList[S ~[]F, F Field](fields S) []Field {
+	selected := make([]Field, len(fields))
+	for i, field := range fields {
+		selected[i] = field
+	}
+	return selected
+}
+
+// checkFields returns an error if any of fields selects an attribute of
+// another class than class. An empty class accepts fields of any class, as
+// in a case feed.
+func checkFields(fields []Field, class string) error {
+	for _, field := range fields {
+		if class != "" && field.FieldClass() != class {
+			return fmt.Errorf("desk: %v field %q cannot select attributes of a %v", field.FieldClass(), field.FieldName(), class)
+		}
+	}
+	return nil
+}
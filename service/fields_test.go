@@ -0,0 +1,72 @@
+package service
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	. "github.com/wtlangford/go-desk/resource"
+	"testing"
+)
+
+func TestFields(t *testing.T) {
+	fmt.Println("")
+	Convey("Get", t, func() {
+		Convey("should send the selected fields", func() {
+			client, server, requests := newTestClient(200, `{"status":"open","_links":{"self":{"href":"/api/v2/cases/1","class":"case"}}}`)
+			defer server.Close()
+			cse, _, err := client.Case.Get("1", CaseFieldId, CaseFieldStatus, CaseFieldUpdatedAt)
+			So(err, ShouldBeNil)
+			So((*requests)[0].RawQuery, ShouldEqual, "fields=id%2Cstatus%2Cupdated_at")
+			So(*cse.Status, ShouldEqual, CaseStatusOpen)
+			So(cse.Blurb, ShouldBeNil)
+			So(cse.CreatedAt, ShouldBeNil)
+		})
+		Convey("should not send fields when none are selected", func() {
+			client, server, requests := newTestClient(200, `{}`)
+			defer server.Close()
+			client.Customer.Get("1")
+			client.Case.Reply.Get("1", "2", ReplyFieldBody)
+			So((*requests)[0].RawQuery, ShouldBeBlank)
+			So((*requests)[1].RawQuery, ShouldEqual, "fields=body")
+		})
+	})
+	Convey("ListOptions", t, func() {
+		Convey("should encode the selected fields", func() {
+			opts := &ListOptions{Fields: []Field{UserFieldName, UserFieldEmail}}
+			So(opts.Params().Get("fields"), ShouldEqual, "name,email")
+		})
+		Convey("should be listed with the fields of the listed resource", func() {
+			client, server, requests := newTestClient(200, `{"total_entries":0,"_embedded":{"entries":[]}}`)
+			defer server.Close()
+			_, _, err := client.User.List(&ListOptions{Fields: []Field{UserFieldName}})
+			So(err, ShouldBeNil)
+			_, _, err = client.Customer.Cases("1", &ListOptions{Fields: []Field{CaseFieldSubject}})
+			So(err, ShouldBeNil)
+			So(len(*requests), ShouldEqual, 2)
+		})
+		Convey("should reject the fields of another resource", func() {
+			client, server, requests := newTestClient(200, `{"total_entries":0,"_embedded":{"entries":[]}}`)
+			defer server.Close()
+			_, _, err := client.Case.List(&ListOptions{Fields: []Field{CaseFieldSubject, UserFieldEmail}})
+			So(err, ShouldNotBeNil)
+			_, _, err = client.Company.Customers("1", &ListOptions{Fields: []Field{CompanyFieldName}})
+			So(err, ShouldNotBeNil)
+			So(len(*requests), ShouldEqual, 0)
+		})
+	})
+	Convey("search queries", t, func() {
+		Convey("should select fields", func() {
+			params, err := NewCaseQuery().Status("open").Fields(CaseFieldId, CaseFieldStatus).Params()
+			So(err, ShouldBeNil)
+			So(params.Get("fields"), ShouldEqual, "id,status")
+			So(params.Get("status"), ShouldEqual, "open")
+		})
+		Convey("should clear fields when none are given", func() {
+			params, _ := NewCustomerQuery().Fields(CustomerFieldEmails).Fields().Params()
+			So(params.Get("fields"), ShouldBeBlank)
+		})
+		Convey("should select company fields", func() {
+			params, _ := NewCompanyQuery().Text("acme").Fields(CompanyFieldName, CompanyFieldDomains).Params()
+			So(params.Get("fields"), ShouldEqual, "name,domains")
+		})
+	})
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"errors"
+	. "github.com/wtlangford/go-desk/resource"
+	"net/http"
+)
+
+// ErrNoLink is returned by Follow when the resource has no such link, or the
+// link is null.
+var ErrNoLink = errors.New("desk: resource has no such link")
+
+// Follow fetches the resource a link of from points to. Links to a single
+// resource return a pointer to the type registered for the link class, e.g.
+// following the customer link of a case returns a *Customer, or a
+// *RawResource for classes with no registered type. Links to a collection,
+// such as the replies of a case, return a navigable *Page.
+func (c *Client) Follow(from Linker, rel string) (interface{}, *http.Response, error) {
+	link, ok := from.GetLink(rel)
+	if !ok {
+		return nil, nil, ErrNoLink
+	}
+	restful := Restful{}
+	if link.IsCollection() {
+		unravel := func(page *Page) error {
+			return page.Embedded.DecodeEntries(link.Class)
+		}
+		page := new(Page)
+		resp, err := restful.
+			Get(link.Href).
+			Json(page).
+			Client(c).
+			Do()
+		if err != nil {
+			return nil, resp, err
+		}
+		err = unravel(page)
+		if err != nil {
+			return nil, nil, err
+		}
+		page.SetNavigator(newPageNavigator(c, unravel))
+		return page, resp, err
+	}
+	linked := NewResource(link.Class)
+	resp, err := restful.
+		Get(link.Href).
+		Json(linked).
+		Client(c).
+		Do()
+	if err != nil {
+		return nil, resp, err
+	}
+	return linked, resp, err
+}
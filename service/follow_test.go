@@ -0,0 +1,61 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	. "github.com/wtlangford/go-desk/resource"
+	"testing"
+)
+
+func TestFollow(t *testing.T) {
+	fmt.Println("")
+	cse := NewCase()
+	json.Unmarshal([]byte(`{"_links":{
+		"self":{"href":"/api/v2/cases/1","class":"case"},
+		"customer":{"href":"/api/v2/customers/2","class":"customer"},
+		"assigned_user":null,
+		"replies":{"href":"/api/v2/cases/1/replies","class":"reply","count":1},
+		"widget":{"href":"/api/v2/widgets/1","class":"widget"}}}`), cse)
+	Convey("Follow", t, func() {
+		Convey("should return a typed resource for a member link", func() {
+			client, server, requests := newTestClient(200, `{"first_name":"Ada","_links":{"self":{"href":"/api/v2/customers/2","class":"customer"}}}`)
+			defer server.Close()
+			linked, _, err := client.Follow(cse, "customer")
+			So(err, ShouldBeNil)
+			So((*requests)[0].Path, ShouldEqual, "/api/v2/customers/2")
+			customer, ok := linked.(*Customer)
+			So(ok, ShouldBeTrue)
+			So(*customer.FirstName, ShouldEqual, "Ada")
+			So(customer.GetResourceId(), ShouldEqual, "2")
+		})
+		Convey("should return a page for a collection link", func() {
+			client, server, requests := newTestClient(200, `{"total_entries":1,"_embedded":{"entries":[{"body":"Thanks","_links":{"self":{"href":"/api/v2/cases/1/replies/3","class":"reply"}}}]}}`)
+			defer server.Close()
+			linked, _, err := client.Follow(cse, "replies")
+			So(err, ShouldBeNil)
+			So((*requests)[0].Path, ShouldEqual, "/api/v2/cases/1/replies")
+			page, ok := linked.(*Page)
+			So(ok, ShouldBeTrue)
+			reply := page.Embedded.Entries[0].(Reply)
+			So(*reply.Body, ShouldEqual, "Thanks")
+		})
+		Convey("should fail for null links", func() {
+			client, server, requests := newTestClient(200, `{}`)
+			defer server.Close()
+			_, _, err := client.Follow(cse, "assigned_user")
+			So(err, ShouldEqual, ErrNoLink)
+			So(len(*requests), ShouldEqual, 0)
+		})
+		Convey("should return a raw resource for unknown classes", func() {
+			client, server, _ := newTestClient(200, `{"size":"large","_links":{"self":{"href":"/api/v2/widgets/1","class":"widget"}}}`)
+			defer server.Close()
+			linked, _, err := client.Follow(cse, "widget")
+			So(err, ShouldBeNil)
+			raw, ok := linked.(*RawResource)
+			So(ok, ShouldBeTrue)
+			So(raw.Class, ShouldEqual, "widget")
+			So(raw.Fields["size"], ShouldEqual, "large")
+		})
+	})
+}
@@ -1,10 +1,8 @@
 package service
 
 import (
-	"encoding/json"
 	. "github.com/wtlangford/go-desk/resource"
 	"net/http"
-	"net/url"
 )
 
 type GroupService struct {
@@ -17,14 +15,16 @@ func NewGroupService(httpClient *Client) *GroupService {
 }
 
 // Get retrieves a group.
+// Only the given fields are returned when any are selected.
 // See Desk API: http://dev.desk.com/API/groups/#show
-func (c *GroupService) Get(id string) (*Group, *http.Response, error) {
+func (c *GroupService) Get(id string, fields ...GroupField) (*Group, *http.Response, error) {
 	restful := Restful{}
 	group := NewGroup()
 	path := NewIdentityResourcePath(id, group)
 	resp, err := restful.
 		Get(path.Path()).
 		Json(group).
+		Params(fieldParams(fieldList(fields))).
 		Client(c.client).
 		Do()
 	return group, resp, err
@@ -32,7 +32,11 @@ func (c *GroupService) Get(id string) (*Group, *http.Response, error) {
 
 // List group with filtering and pagination.
 // See Desk API: http://dev.desk.com/API/groups/#list
-func (c *GroupService) List(params *url.Values) (*Page, *http.Response, error) {
+func (c *GroupService) List(opts *ListOptions) (*Page, *http.Response, error) {
+	params, err := opts.paramsFor("group")
+	if err != nil {
+		return nil, nil, err
+	}
 	restful := Restful{}
 	page := new(Page)
 	path := NewResourcePath(NewGroup())
@@ -49,16 +53,24 @@ func (c *GroupService) List(params *url.Values) (*Page, *http.Response, error) {
 	if err != nil {
 		return nil, nil, err
 	}
+	page.SetNavigator(newPageNavigator(c.client, c.unravelPage))
 	return page, resp, err
 }
 
-func (c *GroupService) Users(id string) (*Page, *http.Response, error) {
+// Users provides a list of users in a group.
+// See Desk API: http://dev.desk.com/API/groups/#list-users
+func (c *GroupService) Users(id string, opts *ListOptions) (*Page, *http.Response, error) {
+	params, err := opts.paramsFor("user")
+	if err != nil {
+		return nil, nil, err
+	}
 	restful := Restful{}
 	page := new(Page)
 	path := NewIdentityResourcePath(id, NewGroup()).SetAction("users")
 	resp, err := restful.
 		Get(path.Path()).
 		Json(page).
+		Params(params).
 		Client(c.client).
 		Do()
 	if err != nil {
@@ -68,35 +80,14 @@ func (c *GroupService) Users(id string) (*Page, *http.Response, error) {
 	if err != nil {
 		return nil, nil, err
 	}
+	page.SetNavigator(newPageNavigator(c.client, c.unravelUserPage))
 	return page, resp, err
 }
 
 func (c *GroupService) unravelPage(page *Page) error {
-	group := new([]Group)
-	err := json.Unmarshal(*page.Embedded.RawEntries, &group)
-	if err != nil {
-		return err
-	}
-	page.Embedded.Entries = make([]interface{}, len(*group))
-	for i, v := range *group {
-		v.InitializeResource(v)
-		page.Embedded.Entries[i] = interface{}(v)
-	}
-	page.Embedded.RawEntries = nil
-	return err
+	return page.Embedded.DecodeEntries("group")
 }
 
 func (c *GroupService) unravelUserPage(page *Page) error {
-	user := new([]User)
-	err := json.Unmarshal(*page.Embedded.RawEntries, &user)
-	if err != nil {
-		return err
-	}
-	page.Embedded.Entries = make([]interface{}, len(*user))
-	for i, v := range *user {
-		v.InitializeResource(v)
-		page.Embedded.Entries[i] = interface{}(v)
-	}
-	page.Embedded.RawEntries = nil
-	return err
+	return page.Embedded.DecodeEntries("user")
 }
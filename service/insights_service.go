@@ -0,0 +1,40 @@
+package service
+
+import (
+	. "github.com/wtlangford/go-desk/resource"
+	"net/http"
+)
+
+type InsightsService struct {
+	client *Client
+}
+
+// Meta retrieves the metrics, dimensions and date range available for
+// reporting.
+// See Desk API: http://dev.desk.com/API/insights/#meta
+func (s *InsightsService) Meta() (*InsightsMeta, *http.Response, error) {
+	restful := Restful{}
+	meta := NewInsightsMeta()
+	path := NewResourcePath(NewInsightsMeta()).SetAction("meta")
+	resp, err := restful.
+		Get(path.Path()).
+		Json(meta).
+		Client(s.client).
+		Do()
+	return meta, resp, err
+}
+
+// Report runs a report query.
+// See Desk API: http://dev.desk.com/API/insights/#reports
+func (s *InsightsService) Report(query *ReportQuery) (*Report, *http.Response, error) {
+	restful := Restful{}
+	report := NewReport()
+	path := NewResourcePath(NewInsightsMeta()).SetAction("reports")
+	resp, err := restful.
+		Post(path.Path()).
+		Body(query).
+		Json(report).
+		Client(s.client).
+		Do()
+	return report, resp, err
+}
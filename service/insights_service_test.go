@@ -0,0 +1,46 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	. "github.com/wtlangford/go-desk/resource"
+	"testing"
+)
+
+func TestInsightsService(t *testing.T) {
+	fmt.Println("")
+	Convey("Meta", t, func() {
+		Convey("should retrieve the insights meta data", func() {
+			client, server, requests := newTestClient(200,
+				`{"start_date":"2013-04-11T00:00:00Z","metrics":["cases_received","cases_resolved"]}`)
+			defer server.Close()
+			meta, _, err := client.Insights.Meta()
+			So(err, ShouldBeNil)
+			So((*requests)[0].Method, ShouldEqual, "GET")
+			So((*requests)[0].Path, ShouldEqual, "/api/v2/insights/meta")
+			So(meta.Metrics, ShouldResemble, []string{"cases_received", "cases_resolved"})
+			So(meta.StartDate.Year(), ShouldEqual, 2013)
+		})
+	})
+	Convey("Report", t, func() {
+		Convey("should post the query and decode typed rows", func() {
+			client, server, requests := newTestClient(200,
+				`{"fields":[{"name":"cases_received","type":"integer"}],"data":[{"date":"2015-03-01","cases_received":7}]}`)
+			defer server.Close()
+			query := NewReportQuery().
+				SetResolution(ReportResolutionDays).
+				AddMetric(ReportMetricCasesReceived)
+			report, _, err := client.Insights.Report(query)
+			So(err, ShouldBeNil)
+			So((*requests)[0].Method, ShouldEqual, "POST")
+			So((*requests)[0].Path, ShouldEqual, "/api/v2/insights/reports")
+			var body map[string]interface{}
+			json.Unmarshal([]byte((*requests)[0].Body), &body)
+			So(body["resolution"], ShouldEqual, "days")
+			So(len(report.Data), ShouldEqual, 1)
+			So(report.Data[0].Metric(ReportMetricCasesReceived), ShouldEqual, 7)
+			So(*report.Fields[0].Name, ShouldEqual, "cases_received")
+		})
+	})
+}
@@ -2,14 +2,92 @@ package service
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	. "github.com/wtlangford/go-desk/resource"
 	"net/http"
+	"time"
 )
 
+const (
+	DefaultJobPollInterval    = time.Second
+	DefaultJobMaxPollInterval = 30 * time.Second
+)
+
+var (
+	ErrJobWaitCanceled = errors.New("desk: waiting for job was canceled")
+	ErrJobWaitTimeout  = errors.New("desk: timed out waiting for job")
+)
+
+// JobError is returned by JobService.Wait when Desk reports that a job failed.
+type JobError struct {
+	Job *Job
+}
+
+func (e *JobError) Error() string {
+	var lastError string
+	if e.Job.LastError != nil {
+		lastError = *e.Job.LastError
+	}
+	return fmt.Sprintf("desk: job %v failed: %v", e.Job.GetResourceId(), lastError)
+}
+
+// WaitOptions controls how JobService.Wait polls a job. The zero value polls
+// every second at first, backing off to every 30 seconds, until the job
+// finishes.
+type WaitOptions struct {
+	// Interval is the delay before the first poll. It doubles after every
+	// poll until it reaches MaxInterval.
+	Interval    time.Duration
+	MaxInterval time.Duration
+	// Timeout limits the total time spent waiting. Zero means no limit.
+	Timeout time.Duration
+	// Progress, when set, is called with the job after every poll.
+	Progress func(job *Job)
+	// Cancel, when closed, stops the wait with ErrJobWaitCanceled, also
+	// while waiting for the next poll. A poll in flight is not interrupted;
+	// the wait stops once it returns.
+	Cancel <-chan struct{}
+}
+
 type JobService struct {
 	client *Client
 }
 
+// JobRequest is the request body used to create a job. For bulk case updates
+// Case holds the changes applied to every case listed in CaseIDs.
+type JobRequest struct {
+	Type    *string `json:"type,omitempty"`
+	Case    *Case   `json:"case,omitempty"`
+	CaseIDs []int   `json:"case_ids,omitempty"`
+}
+
+// MarshalForWrite implements Writable. Case is written the way it is in an
+// update, since the job applies it to existing cases: read-only and
+// create-only fields are left out and unknown enum values are rejected.
+func (r *JobRequest) MarshalForWrite(model interface{}, op Operation) ([]byte, error) {
+	body := struct {
+		Type    *string         `json:"type,omitempty"`
+		Case    json.RawMessage `json:"case,omitempty"`
+		CaseIDs []int           `json:"case_ids,omitempty"`
+	}{Type: r.Type, CaseIDs: r.CaseIDs}
+	if r.Case != nil {
+		data, err := r.Case.MarshalForWrite(r.Case, OperationUpdate)
+		if err != nil {
+			return nil, err
+		}
+		body.Case = data
+	}
+	return json.Marshal(body)
+}
+
+// NewBulkCaseUpdate builds a request that applies the changes in cse to each
+// of the given cases.
+func NewBulkCaseUpdate(cse *Case, caseIds ...int) *JobRequest {
+	jobType := JobTypeBulkCaseUpdate
+	return &JobRequest{Type: &jobType, Case: cse, CaseIDs: caseIds}
+}
+
 // Get retrieves a job.
 // See Desk API: http://dev.desk.com/API/jobs/#show
 func (c *JobService) Get(id string) (*Job, *http.Response, error) {
@@ -24,15 +102,93 @@ func (c *JobService) Get(id string) (*Job, *http.Response, error) {
 	return job, resp, err
 }
 
+// Create a job, such as a bulk case update. The returned job can be polled
+// for progress with Get.
+// See Desk API: http://dev.desk.com/API/jobs/#create
+func (c *JobService) Create(req *JobRequest) (*Job, *http.Response, error) {
+	restful := Restful{}
+	createdJob := NewJob()
+	path := NewResourcePath(NewJob())
+	resp, err := restful.
+		Post(path.Path()).
+		Body(req).
+		Json(createdJob).
+		Client(c.client).
+		Do()
+	return createdJob, resp, err
+}
+
+// Wait polls a job until it completes or fails. A failed job is returned
+// together with a *JobError. The response is the one from the last poll.
+func (c *JobService) Wait(id string, opts *WaitOptions) (*Job, *http.Response, error) {
+	if opts == nil {
+		opts = &WaitOptions{}
+	}
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = DefaultJobPollInterval
+	}
+	maxInterval := opts.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = DefaultJobMaxPollInterval
+	}
+	var timeout <-chan time.Time
+	if opts.Timeout > 0 {
+		timeout = time.After(opts.Timeout)
+	}
+
+	for {
+		job, resp, err := c.Get(id)
+		if err != nil {
+			return nil, resp, err
+		}
+		select {
+		case <-opts.Cancel:
+			return job, resp, ErrJobWaitCanceled
+		default:
+		}
+		if opts.Progress != nil {
+			opts.Progress(job)
+		}
+		if job.LastError != nil && *job.LastError != "" {
+			return job, resp, &JobError{Job: job}
+		}
+		if job.CompletedAt != nil {
+			return job, resp, nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-opts.Cancel:
+			timer.Stop()
+			return job, resp, ErrJobWaitCanceled
+		case <-timeout:
+			timer.Stop()
+			return job, resp, ErrJobWaitTimeout
+		case <-timer.C:
+		}
+
+		interval *= 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
 // List jobs with pagination.
 // See Desk API: http://dev.desk.com/API/jobs/#list
-func (c *JobService) List() (*Page, *http.Response, error) {
+func (c *JobService) List(opts *ListOptions) (*Page, *http.Response, error) {
+	params, err := opts.paramsFor("job")
+	if err != nil {
+		return nil, nil, err
+	}
 	restful := Restful{}
 	page := new(Page)
 	path := NewResourcePath(NewJob())
 	resp, err := restful.
 		Get(path.Path()).
 		Json(page).
+		Params(params).
 		Client(c.client).
 		Do()
 	if err != nil {
@@ -42,20 +198,10 @@ func (c *JobService) List() (*Page, *http.Response, error) {
 	if err != nil {
 		return nil, nil, err
 	}
+	page.SetNavigator(newPageNavigator(c.client, c.unravelPage))
 	return page, resp, err
 }
 
 func (c *JobService) unravelPage(page *Page) error {
-	jobs := new([]Job)
-	err := json.Unmarshal(*page.Embedded.RawEntries, &jobs)
-	if err != nil {
-		return err
-	}
-	page.Embedded.Entries = make([]interface{}, len(*jobs))
-	for i, v := range *jobs {
-		v.InitializeResource(v)
-		page.Embedded.Entries[i] = interface{}(v)
-	}
-	page.Embedded.RawEntries = nil
-	return err
+	return page.Embedded.DecodeEntries("job")
 }
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	. "github.com/wtlangford/go-desk/resource"
+	. "github.com/wtlangford/go-desk/types"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestJobService(t *testing.T) {
+	fmt.Println("")
+	Convey("NewBulkCaseUpdate", t, func() {
+		Convey("should encode the case changes and ids", func() {
+			cse := NewCase()
+			cse.Status = CaseStatusResolved.Ptr()
+			req := NewBulkCaseUpdate(cse, 1, 2, 3)
+			data, err := json.Marshal(req)
+			So(err, ShouldBeNil)
+			So(string(data), ShouldEqual, `{"type":"bulk_case_update","case":{"status":"resolved"},"case_ids":[1,2,3]}`)
+		})
+	})
+	Convey("Create", t, func() {
+		Convey("should post the job and return it", func() {
+			client, server, requests := newTestClient(201,
+				`{"type":"bulk_case_update","progress":0,"_links":{"self":{"href":"/api/v2/jobs/42","class":"job"}}}`)
+			defer server.Close()
+			cse := NewCase()
+			cse.Status = CaseStatusResolved.Ptr()
+			job, _, err := client.Job.Create(NewBulkCaseUpdate(cse, 1, 2))
+			So(err, ShouldBeNil)
+			So((*requests)[0].Method, ShouldEqual, "POST")
+			So((*requests)[0].Path, ShouldEqual, "/api/v2/jobs")
+			So(job.GetResourceId(), ShouldEqual, "42")
+			So(*job.Type, ShouldEqual, JobTypeBulkCaseUpdate)
+			So((*requests)[0].Body, ShouldEqual, "{\"type\":\"bulk_case_update\",\"case\":{\"status\":\"resolved\"},\"case_ids\":[1,2]}\n")
+		})
+		Convey("should write the case as in an update", func() {
+			client, server, requests := newTestClient(201, `{}`)
+			defer server.Close()
+			cse := NewCase()
+			cse.Status = CaseStatusResolved.Ptr()
+			cse.Type = CaseTypeEmail.Ptr()
+			cse.Blurb = String("Help me")
+			_, _, err := client.Job.Create(NewBulkCaseUpdate(cse, 1))
+			So(err, ShouldBeNil)
+			So((*requests)[0].Body, ShouldEqual, "{\"type\":\"bulk_case_update\",\"case\":{\"status\":\"resolved\"},\"case_ids\":[1]}\n")
+		})
+		Convey("should reject unknown enum values in the case", func() {
+			client, server, requests := newTestClient(201, `{}`)
+			defer server.Close()
+			cse := NewCase()
+			cse.Status = CaseStatus("escalated").Ptr()
+			_, _, err := client.Job.Create(NewBulkCaseUpdate(cse, 1))
+			So(err, ShouldNotBeNil)
+			So(len(*requests), ShouldEqual, 0)
+		})
+	})
+}
+
+func TestJobServiceWait(t *testing.T) {
+	fmt.Println("")
+	running := `{"progress":0.5,"_links":{"self":{"href":"/api/v2/jobs/42","class":"job"}}}`
+	completed := `{"progress":1.0,"completed_at":"2015-03-01T10:00:00Z","_links":{"self":{"href":"/api/v2/jobs/42","class":"job"}}}`
+	failed := `{"progress":0.5,"last_error":"case 3 not found","_links":{"self":{"href":"/api/v2/jobs/42","class":"job"}}}`
+	Convey("Wait", t, func() {
+		Convey("should poll until the job completes", func() {
+			client, server, requests := newTestClient(200, running, running, completed)
+			defer server.Close()
+			progress := make([]float64, 0)
+			job, _, err := client.Job.Wait("42", &WaitOptions{
+				Interval: time.Millisecond,
+				Progress: func(job *Job) { progress = append(progress, job.Progress) },
+			})
+			So(err, ShouldBeNil)
+			So(job.CompletedAt, ShouldNotBeNil)
+			So(len(*requests), ShouldEqual, 3)
+			So((*requests)[0].Path, ShouldEqual, "/api/v2/jobs/42")
+			So(progress, ShouldResemble, []float64{0.5, 0.5, 1.0})
+		})
+		Convey("should return a JobError when the job fails", func() {
+			client, server, _ := newTestClient(200, running, failed)
+			defer server.Close()
+			job, _, err := client.Job.Wait("42", &WaitOptions{Interval: time.Millisecond})
+			So(err, ShouldHaveSameTypeAs, &JobError{})
+			So(err.(*JobError).Job, ShouldEqual, job)
+			So(err.Error(), ShouldContainSubstring, "case 3 not found")
+		})
+		Convey("should stop when canceled", func() {
+			client, server, _ := newTestClient(200, running)
+			defer server.Close()
+			cancel := make(chan struct{})
+			close(cancel)
+			_, _, err := client.Job.Wait("42", &WaitOptions{Interval: time.Hour, Cancel: cancel})
+			So(err, ShouldEqual, ErrJobWaitCanceled)
+		})
+		Convey("should stop when canceled while waiting for the next poll", func() {
+			client, server, requests := newTestClient(200, running)
+			defer server.Close()
+			cancel := make(chan struct{})
+			go func() {
+				time.Sleep(20 * time.Millisecond)
+				close(cancel)
+			}()
+			start := time.Now()
+			job, _, err := client.Job.Wait("42", &WaitOptions{Interval: time.Hour, MaxInterval: time.Hour, Cancel: cancel})
+			So(err, ShouldEqual, ErrJobWaitCanceled)
+			So(job.Progress, ShouldEqual, 0.5)
+			So(time.Since(start), ShouldBeLessThan, time.Second)
+			So(len(*requests), ShouldEqual, 1)
+		})
+		Convey("should stop when canceled during a poll", func() {
+			cancel := make(chan struct{})
+			progressed := false
+			client, server := newTestClientFunc(func(w http.ResponseWriter, r *http.Request) {
+				close(cancel)
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, running)
+			})
+			defer server.Close()
+			_, _, err := client.Job.Wait("42", &WaitOptions{
+				Interval: time.Millisecond,
+				Progress: func(job *Job) { progressed = true },
+				Cancel:   cancel,
+			})
+			So(err, ShouldEqual, ErrJobWaitCanceled)
+			So(progressed, ShouldBeFalse)
+		})
+		Convey("should stop after the timeout", func() {
+			client, server, _ := newTestClient(200, running)
+			defer server.Close()
+			_, _, err := client.Job.Wait("42", &WaitOptions{Interval: time.Hour, Timeout: time.Millisecond})
+			So(err, ShouldEqual, ErrJobWaitTimeout)
+		})
+	})
+}
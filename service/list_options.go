@@ -0,0 +1,121 @@
+package service
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	SortAsc  = "asc"
+	SortDesc = "desc"
+)
+
+const (
+	CaseSortCreatedAt  = "created_at"
+	CaseSortUpdatedAt  = "updated_at"
+	CaseSortReceivedAt = "received_at"
+	CaseSortResolvedAt = "resolved_at"
+	CaseSortPriority   = "priority"
+)
+
+const (
+	CustomerSortCreatedAt = "created_at"
+	CustomerSortUpdatedAt = "updated_at"
+)
+
+const (
+	CompanySortCreatedAt = "created_at"
+	CompanySortUpdatedAt = "updated_at"
+	CompanySortName      = "name"
+)
+
+const (
+	UserSortCreatedAt = "created_at"
+	UserSortUpdatedAt = "updated_at"
+	UserSortName      = "name"
+)
+
+const (
+	GroupSortName = "name"
+)
+
+const (
+	ReplySortCreatedAt = "created_at"
+	ReplySortUpdatedAt = "updated_at"
+)
+
+const (
+	NoteSortCreatedAt = "created_at"
+	NoteSortUpdatedAt = "updated_at"
+)
+
+const (
+	JobSortCreatedAt = "created_at"
+)
+
+// ListOptions controls paging, sorting, field selection and embedding for
+// list methods. A nil *ListOptions, or zero valued fields, leave Desk's
+// defaults in place. Fields must select attributes of the listed resources,
+// e.g. CaseField for CaseService.List and CustomerService.Cases; list methods
+// return an error otherwise.
+// See Desk API: http://dev.desk.com/API/using-the-api/#pagination
+type ListOptions struct {
+	Page          int
+	PerPage       int
+	SortField     string
+	SortDirection string
+	SinceId       int
+	Fields        []Field
+	Embed         []string
+	// Extra holds parameters the options do not model, such as the
+	// customer_id, company_id or filter_id filters of CaseService.List. The
+	// options above take precedence over them.
+	Extra url.Values
+}
+
+// Params encodes the options as query parameters.
+func (o *ListOptions) Params() *url.Values {
+	if o == nil {
+		return nil
+	}
+	params := url.Values{}
+	for name, values := range o.Extra {
+		params[name] = append([]string(nil), values...)
+	}
+	if o.Page > 0 {
+		params.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.PerPage > 0 {
+		params.Set("per_page", strconv.Itoa(o.PerPage))
+	}
+	if o.SortField != "" {
+		params.Set("sort_field", o.SortField)
+	}
+	if o.SortDirection != "" {
+		params.Set("sort_direction", o.SortDirection)
+	}
+	if o.SinceId > 0 {
+		params.Set("since_id", strconv.Itoa(o.SinceId))
+	}
+	if len(o.Fields) > 0 {
+		params.Set("fields", joinFields(o.Fields))
+	}
+	if len(o.Embed) > 0 {
+		params.Set("embed", strings.Join(o.Embed, ","))
+	}
+	return &params
+}
+
+// paramsFor encodes the options for a list of resources of class, returning
+// an error if Fields selects attributes of another class.
+func (o *ListOptions) paramsFor(class string) (*url.Values, error) {
+	if o == nil {
+		return nil, nil
+	}
+	err := checkFields(o.Fields, class)
+	if err != nil {
+		return nil, err
+	}
+	return o.Params(), nil
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/url"
+	"testing"
+)
+
+func TestListOptions(t *testing.T) {
+	fmt.Println("")
+	Convey("Params", t, func() {
+		Convey("should be nil for nil options", func() {
+			var opts *ListOptions
+			So(opts.Params(), ShouldBeNil)
+		})
+		Convey("should skip zero values", func() {
+			opts := &ListOptions{}
+			So(opts.Params().Encode(), ShouldBeBlank)
+		})
+		Convey("should encode every option", func() {
+			opts := &ListOptions{
+				Page:          2,
+				PerPage:       100,
+				SortField:     CaseSortUpdatedAt,
+				SortDirection: SortDesc,
+				SinceId:       42,
+				Fields:        []Field{CaseFieldId, CaseFieldStatus},
+				Embed:         []string{"customer", "assigned_user"},
+			}
+			params := opts.Params()
+			So(params.Get("page"), ShouldEqual, "2")
+			So(params.Get("per_page"), ShouldEqual, "100")
+			So(params.Get("sort_field"), ShouldEqual, "updated_at")
+			So(params.Get("sort_direction"), ShouldEqual, "desc")
+			So(params.Get("since_id"), ShouldEqual, "42")
+			So(params.Get("fields"), ShouldEqual, "id,status")
+			So(params.Get("embed"), ShouldEqual, "customer,assigned_user")
+		})
+		Convey("should add extra parameters", func() {
+			opts := &ListOptions{
+				Page:  2,
+				Extra: url.Values{"customer_id": {"7"}, "filter_id": {"3"}, "page": {"9"}},
+			}
+			So(opts.Params().Encode(), ShouldEqual, "customer_id=7&filter_id=3&page=2")
+			So(opts.Extra.Get("page"), ShouldEqual, "9")
+		})
+	})
+	Convey("list methods", t, func() {
+		Convey("should send the options", func() {
+			client, server, requests := newTestClient(200, `{"total_entries":0,"_embedded":{"entries":[]}}`)
+			defer server.Close()
+			opts := &ListOptions{Page: 3, PerPage: 25}
+			client.Job.List(opts)
+			client.Group.Users("1", opts)
+			client.Case.Attachment.List("1", opts)
+			for _, req := range *requests {
+				So(req.RawQuery, ShouldEqual, "page=3&per_page=25")
+			}
+			So(len(*requests), ShouldEqual, 3)
+		})
+		Convey("should send extra parameters", func() {
+			client, server, requests := newTestClient(200, `{"total_entries":0,"_embedded":{"entries":[]}}`)
+			defer server.Close()
+			_, _, err := client.Case.List(&ListOptions{Extra: url.Values{"company_id": {"12"}}})
+			So(err, ShouldBeNil)
+			So((*requests)[0].RawQuery, ShouldEqual, "company_id=12")
+		})
+	})
+}
@@ -28,15 +28,22 @@ func (s *MessageService) Get(caseId string) (*Message, *http.Response, error) {
 // See Desk API: http://dev.desk.com/API/cases/#message-update
 func (s *MessageService) Update(caseId string, msg *Message, params *url.Values) (*Message, *http.Response, error) {
 	restful := Restful{}
+	body, err := changedFields(msg)
+	if err != nil {
+		return nil, nil, err
+	}
 	updatedMsg := NewMessage()
 	path := NewIdentityResourcePath(caseId, NewCase()).SetNested(NewMessage())
 	resp, err := restful.
 		Patch(path.Path()).
-		Body(msg).
+		Body(body).
 		Params(params).
 		Json(updatedMsg).
 		Client(s.client).
 		Do()
+	if err == nil {
+		updated(msg)
+	}
 	return updatedMsg, resp, err
 }
 
@@ -1,10 +1,8 @@
 package service
 
 import (
-	"encoding/json"
 	. "github.com/wtlangford/go-desk/resource"
 	"net/http"
-	"net/url"
 )
 
 type NoteService struct {
@@ -16,7 +14,9 @@ func NewNoteService(httpClient *Client) *NoteService {
 	return s
 }
 
-func (s *NoteService) Get(caseId string, noteId string) (*Note, *http.Response, error) {
+// Get retrieves a note for a case.
+// Only the given fields are returned when any are selected.
+func (s *NoteService) Get(caseId string, noteId string, fields ...NoteField) (*Note, *http.Response, error) {
 	restful := Restful{}
 	note := NewNote()
 	notePath := NewIdentityResourcePath(noteId, NewNote())
@@ -24,12 +24,17 @@ func (s *NoteService) Get(caseId string, noteId string) (*Note, *http.Response,
 	resp, err := restful.
 		Get(path.Path()).
 		Json(note).
+		Params(fieldParams(fieldList(fields))).
 		Client(s.client).
 		Do()
 	return note, resp, err
 }
 
 func (s *NoteService) Create(caseId string, note *Note) (*Note, *http.Response, error) {
+	err := validate(note, OperationCreate)
+	if err != nil {
+		return nil, nil, err
+	}
 	restful := Restful{}
 	createdNote := NewNote()
 	path := NewIdentityResourcePath(caseId, NewCase()).SetNested(createdNote)
@@ -44,19 +49,30 @@ func (s *NoteService) Create(caseId string, note *Note) (*Note, *http.Response,
 
 func (s *NoteService) Update(caseId string, note *Note) (*Note, *http.Response, error) {
 	restful := Restful{}
+	body, err := changedFields(note)
+	if err != nil {
+		return nil, nil, err
+	}
 	updatedNote := NewNote()
 	notePath := NewResourcePath(note).SetMember()
 	path := NewIdentityResourcePath(caseId, NewCase()).AppendPath(notePath)
 	resp, err := restful.
 		Patch(path.Path()).
-		Body(note).
+		Body(body).
 		Json(updatedNote).
 		Client(s.client).
 		Do()
+	if err == nil {
+		updated(note)
+	}
 	return updatedNote, resp, err
 }
 
-func (s *NoteService) List(caseId string, params *url.Values) (*Page, *http.Response, error) {
+func (s *NoteService) List(caseId string, opts *ListOptions) (*Page, *http.Response, error) {
+	params, err := opts.paramsFor("note")
+	if err != nil {
+		return nil, nil, err
+	}
 	restful := Restful{}
 	page := new(Page)
 	path := NewIdentityResourcePath(caseId, NewCase()).SetNested(NewNote())
@@ -73,6 +89,7 @@ func (s *NoteService) List(caseId string, params *url.Values) (*Page, *http.Resp
 	if err != nil {
 		return nil, nil, err
 	}
+	page.SetNavigator(newPageNavigator(s.client, s.unravelPage))
 	return page, resp, err
 }
 
@@ -88,16 +105,5 @@ func (s *NoteService) Delete(caseId string, noteId string) (*http.Response, erro
 }
 
 func (s *NoteService) unravelPage(page *Page) error {
-	notes := new([]Case)
-	err := json.Unmarshal(*page.Embedded.RawEntries, &notes)
-	if err != nil {
-		return err
-	}
-	page.Embedded.Entries = make([]interface{}, len(*notes))
-	for i, v := range *notes {
-		v.InitializeResource(v)
-		page.Embedded.Entries[i] = interface{}(v)
-	}
-	page.Embedded.RawEntries = nil
-	return err
+	return page.Embedded.DecodeEntries("note")
 }
@@ -0,0 +1,37 @@
+package service
+
+import (
+	. "github.com/wtlangford/go-desk/resource"
+	"net/http"
+)
+
+// pageNavigator fetches linked pages for the service that returned a page,
+// decoding their entries with the same unravel function.
+type pageNavigator struct {
+	client  *Client
+	unravel func(*Page) error
+}
+
+func newPageNavigator(client *Client, unravel func(*Page) error) *pageNavigator {
+	return &pageNavigator{client: client, unravel: unravel}
+}
+
+// NavigatePage implements the resource.PageNavigator interface.
+func (n *pageNavigator) NavigatePage(href string) (*Page, *http.Response, error) {
+	restful := Restful{}
+	page := new(Page)
+	resp, err := restful.
+		Get(href).
+		Json(page).
+		Client(n.client).
+		Do()
+	if err != nil {
+		return nil, resp, err
+	}
+	err = n.unravel(page)
+	if err != nil {
+		return nil, nil, err
+	}
+	page.SetNavigator(n)
+	return page, resp, err
+}
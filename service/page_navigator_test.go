@@ -0,0 +1,42 @@
+package service
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	. "github.com/wtlangford/go-desk/resource"
+	"testing"
+)
+
+func TestPageNavigator(t *testing.T) {
+	fmt.Println("")
+	Convey("Next", t, func() {
+		Convey("should fetch the linked page with the same decoder", func() {
+			first := `{"page":1,"total_entries":2,"_links":{
+				"self":{"href":"/api/v2/users?page=1&per_page=1","class":"page"},
+				"next":{"href":"/api/v2/users?page=2&per_page=1","class":"page"}},
+				"_embedded":{"entries":[{"name":"Ada","_links":{"self":{"href":"/api/v2/users/1","class":"user"}}}]}}`
+			second := `{"page":2,"total_entries":2,"_links":{
+				"self":{"href":"/api/v2/users?page=2&per_page=1","class":"page"},
+				"previous":{"href":"/api/v2/users?page=1&per_page=1","class":"page"},
+				"next":null},
+				"_embedded":{"entries":[{"name":"Grace","_links":{"self":{"href":"/api/v2/users/2","class":"user"}}}]}}`
+			client, server, requests := newTestClient(200, first, second, first)
+			defer server.Close()
+			page, _, err := client.User.List(&ListOptions{PerPage: 1})
+			So(err, ShouldBeNil)
+			So(page.TotalPages(), ShouldEqual, 2)
+
+			next, _, err := page.Next()
+			So(err, ShouldBeNil)
+			So((*requests)[1].Path, ShouldEqual, "/api/v2/users")
+			So((*requests)[1].RawQuery, ShouldEqual, "page=2&per_page=1")
+			user := next.Embedded.Entries[0].(User)
+			So(*user.Name, ShouldEqual, "Grace")
+			So(next.HasNext(), ShouldBeFalse)
+
+			prev, _, err := next.Prev()
+			So(err, ShouldBeNil)
+			So(*prev.Embedded.Entries[0].(User).Name, ShouldEqual, "Ada")
+		})
+	})
+}
@@ -0,0 +1,270 @@
+package service
+
+import (
+	"errors"
+	. "github.com/wtlangford/go-desk/resource"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultPrefetchWindow is the number of pages a PageStream fetches ahead
+// when PrefetchOptions.Window is not set.
+const DefaultPrefetchWindow = 4
+
+var ErrPrefetchCanceled = errors.New("desk: page prefetching was canceled")
+
+// PrefetchOptions controls how a PageStream fetches upcoming pages.
+type PrefetchOptions struct {
+	// Window is the number of pages fetched or buffered ahead of the page
+	// being read, which does not count towards it.
+	Window int
+	// RateLimitReserve is the number of requests left to other callers of
+	// the client. Prefetching pauses until the rate limit resets once the
+	// remaining budget drops to it. It defaults to Window.
+	RateLimitReserve int
+	// Cancel, when closed, stops prefetching and Next returns
+	// ErrPrefetchCanceled.
+	Cancel <-chan struct{}
+}
+
+// PageFetcher fetches a page by its number, starting at 1.
+type PageFetcher func(page int) (*Page, *http.Response, error)
+
+type pageResult struct {
+	page *Page
+	err  error
+}
+
+// PageStream delivers the entries of every page of a list in order while
+// fetching upcoming pages concurrently. It stops prefetching after the first
+// error, on cancellation or when closed. A caller that stops reading before
+// Next returns an error must call Close, or the prefetching goroutines are
+// left running.
+type PageStream struct {
+	client  *Client
+	fetch   PageFetcher
+	window  int
+	reserve int
+	cancel  <-chan struct{}
+
+	slots   chan struct{}
+	pending chan chan pageResult
+	done    chan struct{}
+	once    sync.Once
+	mu      sync.Mutex
+	stopErr error
+
+	page  *Page
+	index int
+	err   error
+}
+
+func newPageStream(client *Client, fetch PageFetcher, opts *PrefetchOptions) *PageStream {
+	if opts == nil {
+		opts = &PrefetchOptions{}
+	}
+	window := opts.Window
+	if window <= 0 {
+		window = DefaultPrefetchWindow
+	}
+	reserve := opts.RateLimitReserve
+	if reserve <= 0 {
+		reserve = window
+	}
+	s := &PageStream{
+		client:  client,
+		fetch:   fetch,
+		window:  window,
+		reserve: reserve,
+		cancel:  opts.Cancel,
+		slots:   make(chan struct{}, window),
+		pending: make(chan chan pageResult, window),
+		done:    make(chan struct{}),
+	}
+	go s.dispatch()
+	return s
+}
+
+// Next returns the next entry. It returns io.EOF after the last entry, or
+// the first error met while fetching pages.
+func (s *PageStream) Next() (interface{}, error) {
+	for {
+		if s.err != nil {
+			return nil, s.err
+		}
+		if s.page != nil {
+			if s.index < len(s.page.Embedded.Entries) {
+				entry := s.page.Embedded.Entries[s.index]
+				s.index++
+				return entry, nil
+			}
+			s.page = nil
+		}
+		select {
+		case <-s.cancel:
+			s.fail(ErrPrefetchCanceled)
+			continue
+		default:
+		}
+		var result chan pageResult
+		var ok bool
+		select {
+		case result, ok = <-s.pending:
+		case <-s.cancel:
+			s.fail(ErrPrefetchCanceled)
+			continue
+		}
+		if !ok {
+			s.mu.Lock()
+			s.err = s.stopErr
+			s.mu.Unlock()
+			if s.err == nil {
+				s.err = io.EOF
+			}
+			continue
+		}
+		select {
+		case r := <-result:
+			if r.err != nil {
+				s.fail(r.err)
+				continue
+			}
+			// the page being read no longer counts towards the window
+			<-s.slots
+			s.page = r.page
+			s.index = 0
+		case <-s.cancel:
+			s.fail(ErrPrefetchCanceled)
+		}
+	}
+}
+
+// Close stops prefetching. Pages already being fetched are discarded. It is
+// safe to call Close after Next returned an error.
+func (s *PageStream) Close() {
+	s.stop(nil)
+	if s.err == nil {
+		s.err = io.EOF
+	}
+}
+
+func (s *PageStream) fail(err error) {
+	s.stop(err)
+	s.err = err
+}
+
+func (s *PageStream) stop(err error) {
+	s.once.Do(func() {
+		s.mu.Lock()
+		s.stopErr = err
+		s.mu.Unlock()
+		close(s.done)
+	})
+}
+
+func (s *PageStream) stopped() bool {
+	select {
+	case <-s.done:
+		return true
+	case <-s.cancel:
+		s.stop(ErrPrefetchCanceled)
+		return true
+	default:
+		return false
+	}
+}
+
+// dispatch fetches the first page to learn the number of pages, then fetches
+// the remaining pages while fewer than window pages are ahead of the reader.
+func (s *PageStream) dispatch() {
+	defer close(s.pending)
+	total := make(chan int, 1)
+	if !s.start(1, total) {
+		return
+	}
+	var pages int
+	select {
+	case pages = <-total:
+	case <-s.done:
+		return
+	case <-s.cancel:
+		s.stop(ErrPrefetchCanceled)
+		return
+	}
+	for n := 2; n <= pages; n++ {
+		if !s.waitForBudget() || !s.start(n, nil) {
+			return
+		}
+	}
+}
+
+// start takes a slot and fetches page n in the background. The number of
+// pages is sent on total once the page arrives, when total is not nil.
+func (s *PageStream) start(n int, total chan<- int) bool {
+	select {
+	case s.slots <- struct{}{}:
+	case <-s.done:
+		return false
+	case <-s.cancel:
+		s.stop(ErrPrefetchCanceled)
+		return false
+	}
+	if s.stopped() {
+		return false
+	}
+	result := make(chan pageResult, 1)
+	s.pending <- result
+	go func() {
+		page, _, err := s.fetch(n)
+		if err != nil {
+			s.stop(err)
+		}
+		result <- pageResult{page: page, err: err}
+		if total != nil {
+			if err != nil {
+				total <- 0
+			} else {
+				total <- page.TotalPages()
+			}
+		}
+	}()
+	return true
+}
+
+// waitForBudget pauses until the rate limit resets when the remaining budget
+// is down to the reserve. It returns false if the stream stopped meanwhile.
+func (s *PageStream) waitForBudget() bool {
+	limit, ok := s.client.RateLimit()
+	if !ok || limit.Remaining > s.reserve {
+		return !s.stopped()
+	}
+	wait := limit.Reset.Sub(time.Now())
+	if wait <= 0 {
+		return !s.stopped()
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return !s.stopped()
+	case <-s.done:
+		return false
+	case <-s.cancel:
+		s.stop(ErrPrefetchCanceled)
+		return false
+	}
+}
+
+// listStream streams a list method, fetching each page with a copy of opts.
+func listStream(client *Client, list func(*ListOptions) (*Page, *http.Response, error), opts *ListOptions, prefetch *PrefetchOptions) *PageStream {
+	return newPageStream(client, func(page int) (*Page, *http.Response, error) {
+		pageOpts := ListOptions{}
+		if opts != nil {
+			pageOpts = *opts
+		}
+		pageOpts.Page = page
+		return list(&pageOpts)
+	}, prefetch)
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	. "github.com/wtlangford/go-desk/resource"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// pagedCases answers case list requests with total cases split into pages of
+// perPage, one entry per case, recording the highest number of concurrent
+// requests.
+type pagedCases struct {
+	total    int
+	perPage  int
+	delay    time.Duration
+	failPage int
+	mu       sync.Mutex
+	active   int
+	peak     int
+	pages    []int
+}
+
+func (p *pagedCases) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	p.mu.Lock()
+	p.active++
+	if p.active > p.peak {
+		p.peak = p.active
+	}
+	p.pages = append(p.pages, page)
+	p.mu.Unlock()
+	time.Sleep(p.delay)
+	p.mu.Lock()
+	p.active--
+	p.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Rate-Limit-Limit", "60")
+	w.Header().Set("X-Rate-Limit-Remaining", "50")
+	w.Header().Set("X-Rate-Limit-Reset", "30")
+	if page == p.failPage {
+		w.WriteHeader(500)
+		fmt.Fprint(w, `{"message":"boom"}`)
+		return
+	}
+	entries := ""
+	for id := (page-1)*p.perPage + 1; id <= page*p.perPage && id <= p.total; id++ {
+		if entries != "" {
+			entries += ","
+		}
+		entries += fmt.Sprintf(`{"subject":"case %d","_links":{"self":{"href":"/api/v2/cases/%d","class":"case"}}}`, id, id)
+	}
+	fmt.Fprintf(w, `{"page":%d,"total_entries":%d,"_links":{"self":{"href":"/api/v2/cases?page=%d&per_page=%d","class":"page"}},"_embedded":{"entries":[%s]}}`,
+		page, p.total, page, p.perPage, entries)
+}
+
+func readSubjects(stream *PageStream) ([]string, error) {
+	subjects := make([]string, 0)
+	for {
+		entry, err := stream.Next()
+		if err != nil {
+			return subjects, err
+		}
+		cse := entry.(Case)
+		subjects = append(subjects, *cse.Subject)
+	}
+}
+
+func TestPageStream(t *testing.T) {
+	fmt.Println("")
+	Convey("ListStream", t, func() {
+		Convey("should deliver every entry in order", func() {
+			cases := &pagedCases{total: 23, perPage: 5, delay: 20 * time.Millisecond}
+			client, server := newTestClientFunc(cases.ServeHTTP)
+			defer server.Close()
+			stream := client.Case.ListStream(&ListOptions{PerPage: 5}, &PrefetchOptions{Window: 3})
+			subjects, err := readSubjects(stream)
+			So(err, ShouldEqual, io.EOF)
+			So(len(subjects), ShouldEqual, 23)
+			for i, subject := range subjects {
+				So(subject, ShouldEqual, fmt.Sprintf("case %d", i+1))
+			}
+			So(len(cases.pages), ShouldEqual, 5)
+			So(cases.peak, ShouldBeGreaterThan, 1)
+			So(cases.peak, ShouldBeLessThanOrEqualTo, 3)
+		})
+		Convey("should fetch one page ahead of the page being read with a window of one", func() {
+			cases := &pagedCases{total: 15, perPage: 5}
+			client, server := newTestClientFunc(cases.ServeHTTP)
+			defer server.Close()
+			stream := client.Case.ListStream(&ListOptions{PerPage: 5}, &PrefetchOptions{Window: 1})
+			defer stream.Close()
+			_, err := stream.Next()
+			So(err, ShouldBeNil)
+			time.Sleep(100 * time.Millisecond)
+			cases.mu.Lock()
+			defer cases.mu.Unlock()
+			So(cases.pages, ShouldResemble, []int{1, 2})
+		})
+		Convey("should stop at the first failed page", func() {
+			cases := &pagedCases{total: 50, perPage: 5, failPage: 3}
+			client, server := newTestClientFunc(cases.ServeHTTP)
+			defer server.Close()
+			stream := client.Case.ListStream(&ListOptions{PerPage: 5}, &PrefetchOptions{Window: 2})
+			subjects, err := readSubjects(stream)
+			So(err, ShouldNotBeNil)
+			So(err, ShouldNotEqual, io.EOF)
+			So(len(subjects), ShouldEqual, 10)
+			_, err = stream.Next()
+			So(err, ShouldNotEqual, io.EOF)
+			So(len(cases.pages), ShouldBeLessThan, 10)
+		})
+		Convey("should stop when canceled", func() {
+			cases := &pagedCases{total: 50, perPage: 5}
+			client, server := newTestClientFunc(cases.ServeHTTP)
+			defer server.Close()
+			cancel := make(chan struct{})
+			stream := client.Case.ListStream(&ListOptions{PerPage: 5}, &PrefetchOptions{Window: 2, Cancel: cancel})
+			_, err := stream.Next()
+			So(err, ShouldBeNil)
+			close(cancel)
+			for err == nil {
+				_, err = stream.Next()
+			}
+			So(err, ShouldEqual, ErrPrefetchCanceled)
+		})
+		Convey("should stop when closed", func() {
+			cases := &pagedCases{total: 50, perPage: 5}
+			client, server := newTestClientFunc(cases.ServeHTTP)
+			defer server.Close()
+			stream := client.Case.ListStream(&ListOptions{PerPage: 5}, nil)
+			stream.Next()
+			stream.Close()
+			_, err := stream.Next()
+			So(err, ShouldEqual, io.EOF)
+		})
+		Convey("should wait for the rate limit to reset", func() {
+			cases := &pagedCases{total: 10, perPage: 5}
+			client, server := newTestClientFunc(cases.ServeHTTP)
+			defer server.Close()
+			cancel := make(chan struct{})
+			stream := client.Case.ListStream(&ListOptions{PerPage: 5}, &PrefetchOptions{RateLimitReserve: 50, Cancel: cancel})
+			for i := 0; i < 5; i++ {
+				_, err := stream.Next()
+				So(err, ShouldBeNil)
+			}
+			close(cancel)
+			_, err := stream.Next()
+			So(err, ShouldEqual, ErrPrefetchCanceled)
+			So(cases.pages, ShouldResemble, []int{1})
+		})
+	})
+	Convey("RateLimit", t, func() {
+		Convey("should report the latest rate limit headers", func() {
+			cases := &pagedCases{total: 1, perPage: 5}
+			client, server := newTestClientFunc(cases.ServeHTTP)
+			defer server.Close()
+			_, ok := client.RateLimit()
+			So(ok, ShouldBeFalse)
+			client.Case.List(nil)
+			limit, ok := client.RateLimit()
+			So(ok, ShouldBeTrue)
+			So(limit.Limit, ShouldEqual, 60)
+			So(limit.Remaining, ShouldEqual, 50)
+			So(limit.Reset, ShouldHappenAfter, time.Now().Add(20*time.Second))
+		})
+	})
+}
@@ -1,10 +1,8 @@
 package service
 
 import (
-	"encoding/json"
 	. "github.com/wtlangford/go-desk/resource"
 	"net/http"
-	"net/url"
 )
 
 type ReplyService struct {
@@ -12,8 +10,9 @@ type ReplyService struct {
 }
 
 // Get retrieves a reply for a case.
+// Only the given fields are returned when any are selected.
 // See Desk API: http://dev.desk.com/API/cases/#replies-show
-func (c *ReplyService) Get(caseId string, replyId string) (*Reply, *http.Response, error) {
+func (c *ReplyService) Get(caseId string, replyId string, fields ...ReplyField) (*Reply, *http.Response, error) {
 	restful := Restful{}
 	reply := NewReply()
 	replyPath := NewIdentityResourcePath(replyId, NewReply())
@@ -21,6 +20,7 @@ func (c *ReplyService) Get(caseId string, replyId string) (*Reply, *http.Respons
 	resp, err := restful.
 		Get(casePath.Path()).
 		Json(reply).
+		Params(fieldParams(fieldList(fields))).
 		Client(c.client).
 		Do()
 	return reply, resp, err
@@ -28,7 +28,11 @@ func (c *ReplyService) Get(caseId string, replyId string) (*Reply, *http.Respons
 
 // List replies with filtering and pagination.
 // See Desk API: http://dev.desk.com/API/cases/#replies-list
-func (c *ReplyService) List(caseId string, params *url.Values) (*Page, *http.Response, error) {
+func (c *ReplyService) List(caseId string, opts *ListOptions) (*Page, *http.Response, error) {
+	params, err := opts.paramsFor("reply")
+	if err != nil {
+		return nil, nil, err
+	}
 	restful := Restful{}
 	page := new(Page)
 	replyPath := NewResourcePath(NewReply())
@@ -46,12 +50,17 @@ func (c *ReplyService) List(caseId string, params *url.Values) (*Page, *http.Res
 	if err != nil {
 		return nil, nil, err
 	}
+	page.SetNavigator(newPageNavigator(c.client, c.unravelPage))
 	return page, resp, err
 }
 
 // Create a reply.
 // See Desk API: http://dev.desk.com/API/cases/#replies-create
 func (c *ReplyService) Create(caseId string, reply *Reply) (*Reply, *http.Response, error) {
+	err := validate(reply, OperationCreate)
+	if err != nil {
+		return nil, nil, err
+	}
 	restful := Restful{}
 	createdReply := NewReply()
 	replyPath := NewResourcePath(createdReply)
@@ -69,14 +78,21 @@ func (c *ReplyService) Create(caseId string, reply *Reply) (*Reply, *http.Respon
 // See Desk API: http://dev.desk.com/API/replies/#update
 func (c *ReplyService) Update(caseId string, reply *Reply) (*Reply, *http.Response, error) {
 	restful := Restful{}
+	body, err := changedFields(reply)
+	if err != nil {
+		return nil, nil, err
+	}
 	updatedReply := NewReply()
 	casePath := NewIdentityResourcePath(caseId, NewCase()).SetNested(reply)
 	resp, err := restful.
 		Patch(casePath.Path()).
-		Body(reply).
+		Body(body).
 		Json(updatedReply).
 		Client(c.client).
 		Do()
+	if err == nil {
+		updated(reply)
+	}
 	return updatedReply, resp, err
 }
 
@@ -93,16 +109,5 @@ func (c *ReplyService) Delete(caseId string, replyId string) (*http.Response, er
 }
 
 func (c *ReplyService) unravelPage(page *Page) error {
-	replies := new([]Reply)
-	err := json.Unmarshal(*page.Embedded.RawEntries, &replies)
-	if err != nil {
-		return err
-	}
-	page.Embedded.Entries = make([]interface{}, len(*replies))
-	for i, v := range *replies {
-		v.InitializeResource(v)
-		page.Embedded.Entries[i] = interface{}(v)
-	}
-	page.Embedded.RawEntries = nil
-	return err
+	return page.Embedded.DecodeEntries("reply")
 }
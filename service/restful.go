@@ -5,6 +5,7 @@ import (
 	. "github.com/wtlangford/go-desk/types"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 type Restful struct {
@@ -84,10 +85,15 @@ func (r *Restful) Json(j interface{}) *Restful {
 
 func (r *Restful) Do() (*http.Response, error) {
 	path := r.path
+	query := make([]string, 0, 2)
 	if r.params != nil && len(*r.params) > 0 {
-		path = fmt.Sprintf("%v?%v", path, r.params.Encode())
-	} else if r.query != nil {
-		path = fmt.Sprintf("%v?%v", path, r.query)
+		query = append(query, r.params.Encode())
+	}
+	if r.query != nil && *r.query != "" {
+		query = append(query, *r.query)
+	}
+	if len(query) > 0 {
+		path = fmt.Sprintf("%v?%v", path, strings.Join(query, "&"))
 	}
 	req, err := r.client.NewRequest(r.method, path, r.body)
 	if err != nil {
@@ -3,6 +3,7 @@ package service
 import (
 	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
+	"net/url"
 	"testing"
 )
 
@@ -49,3 +50,19 @@ func TestRestful(t *testing.T) {
 		})
 	})
 }
+
+func TestRestfulQuery(t *testing.T) {
+	fmt.Println("")
+	Convey("Do", t, func() {
+		Convey("should combine params and the raw query", func() {
+			client, server, requests := newTestClient(200, "")
+			defer server.Close()
+			params := url.Values{}
+			params.Set("status", "open")
+			query := "q=printer"
+			r := Restful{}
+			r.Get("cases/search").Params(&params).Query(&query).Client(client).Do()
+			So((*requests)[0].RawQuery, ShouldEqual, "status=open&q=printer")
+		})
+	})
+}
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"errors"
+	. "github.com/wtlangford/go-desk/resource"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultSearchResultCap is the number of results Desk lets a single
+	// search page through.
+	DefaultSearchResultCap = 5000
+	// DefaultSearchAllPerPage is the page size used by SearchAll.
+	DefaultSearchAllPerPage = 100
+)
+
+const (
+	SearchWindowCreatedAt = "created_at"
+	SearchWindowUpdatedAt = "updated_at"
+)
+
+// ErrSearchCapExceeded is returned by SearchAll, together with the results it
+// could retrieve, when a time window of a second still holds more results
+// than Desk returns for a single search.
+var ErrSearchCapExceeded = errors.New("desk: search results exceed the result cap within a single second")
+
+// SearchAllOptions controls how SearchAll splits a search into time windows.
+type SearchAllOptions struct {
+	// Window is the timestamp the search is split on, SearchWindowCreatedAt
+	// (the default) or SearchWindowUpdatedAt.
+	Window string
+	// Since and Until bound the search. They default to the unix epoch and
+	// the current time, and are narrowed to the bounds of the query on the
+	// Window timestamp, if any.
+	Since time.Time
+	Until time.Time
+	// PerPage defaults to DefaultSearchAllPerPage.
+	PerPage int
+	// ResultCap defaults to DefaultSearchResultCap.
+	ResultCap int
+}
+
+// searchCollector gathers the entries of all windows, skipping entries that
+// were already returned by a neighbouring window.
+type searchCollector struct {
+	search  func(params *url.Values, q *string) (*Page, *http.Response, error)
+	base    *url.Values
+	opts    SearchAllOptions
+	seen    map[string]bool
+	entries []interface{}
+	capped  bool
+}
+
+// SearchAll retrieves every case matching query, beyond the number of results
+// Desk returns for a single search. The search is split into time windows,
+// which are halved until each holds fewer results than the cap.
+func (s *CaseService) SearchAll(query *CaseQuery, opts *SearchAllOptions) ([]interface{}, error) {
+	params, err := query.Params()
+	if err != nil {
+		return nil, err
+	}
+	return searchAll(s.Search, params, opts)
+}
+
+// SearchAll retrieves every customer matching query, beyond the number of
+// results Desk returns for a single search. The search is split into time
+// windows, which are halved until each holds fewer results than the cap.
+func (c *CustomerService) SearchAll(query *CustomerQuery, opts *SearchAllOptions) ([]interface{}, error) {
+	params, err := query.Params()
+	if err != nil {
+		return nil, err
+	}
+	return searchAll(c.Search, params, opts)
+}
+
+func searchAll(search func(*url.Values, *string) (*Page, *http.Response, error), base *url.Values, opts *SearchAllOptions) ([]interface{}, error) {
+	collector := &searchCollector{
+		search: search,
+		base:   base,
+		seen:   make(map[string]bool),
+	}
+	if opts != nil {
+		collector.opts = *opts
+	}
+	if collector.opts.Window == "" {
+		collector.opts.Window = SearchWindowCreatedAt
+	}
+	if collector.opts.Since.IsZero() {
+		collector.opts.Since = time.Unix(0, 0)
+	}
+	if collector.opts.Until.IsZero() {
+		collector.opts.Until = time.Now()
+	}
+	if collector.opts.PerPage <= 0 {
+		collector.opts.PerPage = DefaultSearchAllPerPage
+	}
+	if collector.opts.ResultCap <= 0 {
+		collector.opts.ResultCap = DefaultSearchResultCap
+	}
+
+	since, until, err := collector.window()
+	if err != nil || since > until {
+		return nil, err
+	}
+	err = collector.collect(since, until)
+	if err == nil && collector.capped {
+		err = ErrSearchCapExceeded
+	}
+	return collector.entries, err
+}
+
+// window returns the unix seconds searched, the overlap of Since and Until
+// with the bounds the query already holds on the window timestamp, such as
+// those set by CaseQuery.CreatedBetween.
+func (c *searchCollector) window() (int64, int64, error) {
+	since := c.opts.Since.Unix()
+	until := c.opts.Until.Unix()
+	if value := c.base.Get("since_" + c.opts.Window); value != "" {
+		bound, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return 0, 0, err
+		}
+		if bound > since {
+			since = bound
+		}
+	}
+	if value := c.base.Get("max_" + c.opts.Window); value != "" {
+		bound, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return 0, 0, err
+		}
+		if bound < until {
+			until = bound
+		}
+	}
+	return since, until, nil
+}
+
+// collect retrieves the window between since and until, both unix seconds,
+// splitting it in half while it holds more results than the cap.
+func (c *searchCollector) collect(since int64, until int64) error {
+	page, err := c.page(since, until, 1)
+	if err != nil {
+		return err
+	}
+	total := 0
+	if page.TotalEntries != nil {
+		total = *page.TotalEntries
+	}
+	if total > c.opts.ResultCap {
+		if until-since > 1 {
+			middle := since + (until-since)/2
+			if err := c.collect(since, middle); err != nil {
+				return err
+			}
+			return c.collect(middle, until)
+		}
+		c.capped = true
+		total = c.opts.ResultCap
+	}
+
+	c.add(page)
+	pages := (total + c.opts.PerPage - 1) / c.opts.PerPage
+	for n := 2; n <= pages; n++ {
+		page, err = c.page(since, until, n)
+		if err != nil {
+			return err
+		}
+		c.add(page)
+	}
+	return nil
+}
+
+func (c *searchCollector) page(since int64, until int64, n int) (*Page, error) {
+	params := url.Values{}
+	for k, v := range *c.base {
+		params[k] = v
+	}
+	params.Set("since_"+c.opts.Window, strconv.FormatInt(since, 10))
+	params.Set("max_"+c.opts.Window, strconv.FormatInt(until, 10))
+	params.Set("per_page", strconv.Itoa(c.opts.PerPage))
+	params.Set("page", strconv.Itoa(n))
+	page, _, err := c.search(&params, nil)
+	return page, err
+}
+
+func (c *searchCollector) add(page *Page) {
+	if page.Embedded == nil {
+		return
+	}
+	for _, entry := range page.Embedded.Entries {
+		id := entryId(entry)
+		if id != "" {
+			if c.seen[id] {
+				continue
+			}
+			c.seen[id] = true
+		}
+		c.entries = append(c.entries, entry)
+	}
+}
+
+// entryId returns the resource id of a page entry, which pages hold by value.
+func entryId(entry interface{}) string {
+	if r, ok := entry.(Resourceful); ok {
+		return r.GetResourceId()
+	}
+	v := reflect.ValueOf(entry)
+	ptr := reflect.New(v.Type())
+	ptr.Elem().Set(v)
+	if r, ok := ptr.Interface().(Resourceful); ok {
+		return r.GetResourceId()
+	}
+	return ""
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	. "github.com/wtlangford/go-desk/resource"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// fakeCaseSearch answers case searches from cases created one per second
+// starting at the unix time start, honouring the created_at window and
+// paging parameters.
+func fakeCaseSearch(start int64, count int, searches *int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*searches++
+		query := r.URL.Query()
+		since, _ := strconv.ParseInt(query.Get("since_created_at"), 10, 64)
+		max, _ := strconv.ParseInt(query.Get("max_created_at"), 10, 64)
+		page, _ := strconv.Atoi(query.Get("page"))
+		perPage, _ := strconv.Atoi(query.Get("per_page"))
+		matches := make([]map[string]interface{}, 0)
+		for i := 0; i < count; i++ {
+			created := start + int64(i)
+			if created >= since && created <= max {
+				matches = append(matches, map[string]interface{}{
+					"id":         i + 1,
+					"created_at": created,
+				})
+			}
+		}
+		entries := make([]map[string]interface{}, 0)
+		for i := (page - 1) * perPage; i < page*perPage && i < len(matches); i++ {
+			entries = append(entries, matches[i])
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"total_entries": len(matches),
+			"page":          page,
+			"_embedded":     map[string]interface{}{"entries": entries},
+		})
+	}
+}
+
+func TestSearchAll(t *testing.T) {
+	fmt.Println("")
+	start := int64(1425168000)
+	opts := func() *SearchAllOptions {
+		return &SearchAllOptions{
+			Since:     time.Unix(start, 0),
+			Until:     time.Unix(start+40, 0),
+			PerPage:   2,
+			ResultCap: 5,
+		}
+	}
+	Convey("SearchAll", t, func() {
+		Convey("should page through a window below the cap", func() {
+			searches := 0
+			client, server := newTestClientFunc(fakeCaseSearch(start, 5, &searches))
+			defer server.Close()
+			entries, err := client.Case.SearchAll(NewCaseQuery().Status("open"), opts())
+			So(err, ShouldBeNil)
+			So(len(entries), ShouldEqual, 5)
+			So(searches, ShouldEqual, 3)
+		})
+		Convey("should split windows above the cap and drop duplicates", func() {
+			searches := 0
+			client, server := newTestClientFunc(fakeCaseSearch(start, 23, &searches))
+			defer server.Close()
+			entries, err := client.Case.SearchAll(NewCaseQuery(), opts())
+			So(err, ShouldBeNil)
+			So(len(entries), ShouldEqual, 23)
+			seen := make(map[int]bool)
+			for _, entry := range entries {
+				cse := entry.(Case)
+				So(seen[*cse.Id], ShouldBeFalse)
+				seen[*cse.Id] = true
+			}
+		})
+		Convey("should report windows that cannot be split any further", func() {
+			searches := 0
+			client, server := newTestClientFunc(func(w http.ResponseWriter, r *http.Request) {
+				searches++
+				fmt.Fprint(w, `{"total_entries":100,"_embedded":{"entries":[{"id":1}]}}`)
+			})
+			defer server.Close()
+			o := opts()
+			o.Until = time.Unix(start+1, 0)
+			entries, err := client.Case.SearchAll(NewCaseQuery(), o)
+			So(err, ShouldEqual, ErrSearchCapExceeded)
+			So(len(entries), ShouldEqual, 1)
+		})
+		Convey("should keep within the bounds of the query", func() {
+			searches := 0
+			client, server := newTestClientFunc(fakeCaseSearch(start, 23, &searches))
+			defer server.Close()
+			query := NewCaseQuery().CreatedBetween(time.Unix(start+10, 0), time.Unix(start+14, 0))
+			entries, err := client.Case.SearchAll(query, opts())
+			So(err, ShouldBeNil)
+			So(len(entries), ShouldEqual, 5)
+			for _, entry := range entries {
+				cse := entry.(Case)
+				So(*cse.Id, ShouldBeGreaterThanOrEqualTo, 11)
+				So(*cse.Id, ShouldBeLessThanOrEqualTo, 15)
+			}
+		})
+		Convey("should not search when the query is outside Since and Until", func() {
+			searches := 0
+			client, server := newTestClientFunc(fakeCaseSearch(start, 23, &searches))
+			defer server.Close()
+			query := NewCaseQuery().CreatedBetween(time.Unix(start+100, 0), time.Unix(start+200, 0))
+			entries, err := client.Case.SearchAll(query, opts())
+			So(err, ShouldBeNil)
+			So(entries, ShouldBeEmpty)
+			So(searches, ShouldEqual, 0)
+		})
+		Convey("should not search with an invalid query", func() {
+			searches := 0
+			client, server := newTestClientFunc(fakeCaseSearch(start, 5, &searches))
+			defer server.Close()
+			_, err := client.Case.SearchAll(NewCaseQuery().Where("nope", 1), opts())
+			So(err, ShouldNotBeNil)
+			So(searches, ShouldEqual, 0)
+		})
+	})
+}
@@ -0,0 +1,435 @@
+package service
+
+import (
+	"fmt"
+	. "github.com/wtlangford/go-desk/resource"
+	. "github.com/wtlangford/go-desk/types"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type searchFieldKind int
+
+const (
+	searchText searchFieldKind = iota
+	searchInt
+	searchTime
+)
+
+// customFieldPrefix marks search parameters that filter on custom fields.
+const customFieldPrefix = "custom_"
+
+var caseSearchFields = map[string]searchFieldKind{
+	"q":                searchText,
+	"name":             searchText,
+	"first_name":       searchText,
+	"last_name":        searchText,
+	"email":            searchText,
+	"phone":            searchText,
+	"company":          searchText,
+	"twitter":          searchText,
+	"labels":           searchText,
+	"case_id":          searchInt,
+	"subject":          searchText,
+	"description":      searchText,
+	"status":           searchText,
+	"priority":         searchInt,
+	"assigned_group":   searchText,
+	"assigned_user":    searchText,
+	"channels":         searchText,
+	"notes":            searchText,
+	"attachments":      searchText,
+	"created":          searchText,
+	"updated":          searchText,
+	"since_created_at": searchTime,
+	"max_created_at":   searchTime,
+	"since_updated_at": searchTime,
+	"max_updated_at":   searchTime,
+	"since_id":         searchInt,
+	"max_id":           searchInt,
+}
+
+var customerSearchFields = map[string]searchFieldKind{
+	"q":                searchText,
+	"first_name":       searchText,
+	"last_name":        searchText,
+	"full_name":        searchText,
+	"email":            searchText,
+	"phone":            searchText,
+	"twitter":          searchText,
+	"external_id":      searchText,
+	"since_created_at": searchTime,
+	"max_created_at":   searchTime,
+	"since_updated_at": searchTime,
+	"max_updated_at":   searchTime,
+	"since_id":         searchInt,
+	"max_id":           searchInt,
+}
+
+var companySearchFields = map[string]searchFieldKind{
+	"q": searchText,
+}
+
+// SearchFieldError reports a search parameter that does not exist for a
+// resource, or a value of the wrong type.
+type SearchFieldError struct {
+	Field  string
+	Value  interface{}
+	Reason string
+}
+
+func (e *SearchFieldError) Error() string {
+	if e.Value == nil {
+		return fmt.Sprintf("desk: search field %v: %v", e.Field, e.Reason)
+	}
+	return fmt.Sprintf("desk: search field %v: %v (%#v)", e.Field, e.Reason, e.Value)
+}
+
+// searchQuery holds the parameters shared by the typed search queries. The
+// first invalid field or value is kept and returned by Params.
+type searchQuery struct {
+	fields map[string]searchFieldKind
+	params url.Values
+	err    error
+}
+
+func newSearchQuery(fields map[string]searchFieldKind) searchQuery {
+	return searchQuery{fields: fields, params: url.Values{}}
+}
+
+// Params encodes the query as the parameters Desk expects, or returns the
+// first invalid field or value that was added.
+func (q *searchQuery) Params() (*url.Values, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	params := url.Values{}
+	for k, v := range q.params {
+		params[k] = append([]string(nil), v...)
+	}
+	return &params, nil
+}
+
+// Err returns the first invalid field or value that was added, if any.
+func (q *searchQuery) Err() error {
+	return q.err
+}
+
+func (q *searchQuery) where(field string, values []interface{}) {
+	if q.err != nil {
+		return
+	}
+	kind, ok := q.fields[field]
+	if !ok && strings.HasPrefix(field, customFieldPrefix) && len(field) > len(customFieldPrefix) {
+		kind, ok = searchText, true
+	}
+	if !ok {
+		q.err = &SearchFieldError{Field: field, Reason: "unknown field"}
+		return
+	}
+	if len(values) == 0 {
+		q.err = &SearchFieldError{Field: field, Reason: "no value"}
+		return
+	}
+	encoded := make([]string, 0, len(values))
+	for _, value := range values {
+		s, err := encodeSearchValue(field, kind, value)
+		if err != nil {
+			q.err = err
+			return
+		}
+		encoded = append(encoded, s)
+	}
+	q.params.Set(field, strings.Join(encoded, ","))
+}
+
+// selectFields limits the attributes returned for each result.
+func (q *searchQuery) selectFields(fields []Field) {
+	if len(fields) == 0 {
+		q.params.Del("fields")
+		return
+	}
+	q.params.Set("fields", joinFields(fields))
+}
+
+func (q *searchQuery) between(since string, max string, from time.Time, to time.Time) {
+	if !from.IsZero() {
+		q.where(since, []interface{}{from})
+	}
+	if !to.IsZero() {
+		q.where(max, []interface{}{to})
+	}
+}
+
+// SetSearchTime sets a search parameter taking a time, such as
+// since_created_at or max_updated_at, to the Unix seconds Desk expects. It is
+// meant for the raw params of Search; typed queries convert times themselves.
+func SetSearchTime(params *url.Values, name string, t time.Time) {
+	params.Set(name, Timestamp{Time: t}.UnixString())
+}
+
+func encodeSearchValue(field string, kind searchFieldKind, value interface{}) (string, error) {
+	switch kind {
+	case searchInt:
+		switch v := value.(type) {
+		case int:
+			return strconv.Itoa(v), nil
+		case int64:
+			return strconv.FormatInt(v, 10), nil
+		}
+		return "", &SearchFieldError{Field: field, Value: value, Reason: "expected an integer"}
+	case searchTime:
+		switch v := value.(type) {
+		case time.Time:
+			return strconv.FormatInt(v.Unix(), 10), nil
+		case Timestamp:
+			return v.UnixString(), nil
+		case *Timestamp:
+			if v != nil {
+				return v.UnixString(), nil
+			}
+		}
+		return "", &SearchFieldError{Field: field, Value: value, Reason: "expected a time"}
+	default:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case int:
+			return strconv.Itoa(v), nil
+		case bool:
+			return strconv.FormatBool(v), nil
+		}
+		// typed strings such as CaseStatus
+		if v := reflect.ValueOf(value); v.Kind() == reflect.String {
+			return v.String(), nil
+		}
+		return "", &SearchFieldError{Field: field, Value: value, Reason: "expected a string"}
+	}
+}
+
+func stringValues(values []string) []interface{} {
+	converted := make([]interface{}, len(values))
+	for i, v := range values {
+		converted[i] = v
+	}
+	return converted
+}
+
+func intValues(values []int) []interface{} {
+	converted := make([]interface{}, len(values))
+	for i, v := range values {
+		converted[i] = v
+	}
+	return converted
+}
+
+// CaseQuery builds the parameters of a case search. Multiple values for a
+// field match any of them.
+// See Desk API: http://dev.desk.com/API/cases/#search
+type CaseQuery struct {
+	searchQuery
+}
+
+func NewCaseQuery() *CaseQuery {
+	return &CaseQuery{newSearchQuery(caseSearchFields)}
+}
+
+// Where adds a search field by its Desk parameter name, e.g. "status" or
+// "custom_level".
+func (q *CaseQuery) Where(field string, values ...interface{}) *CaseQuery {
+	q.where(field, values)
+	return q
+}
+
+// Text searches cases for free text.
+func (q *CaseQuery) Text(text string) *CaseQuery {
+	return q.Where("q", text)
+}
+
+func (q *CaseQuery) Status(statuses ...CaseStatus) *CaseQuery {
+	values := make([]interface{}, len(statuses))
+	for i, status := range statuses {
+		values[i] = status
+	}
+	return q.Where("status", values...)
+}
+
+func (q *CaseQuery) Labels(labels ...string) *CaseQuery {
+	return q.Where("labels", stringValues(labels)...)
+}
+
+func (q *CaseQuery) Channels(channels ...CaseType) *CaseQuery {
+	values := make([]interface{}, len(channels))
+	for i, channel := range channels {
+		values[i] = channel
+	}
+	return q.Where("channels", values...)
+}
+
+func (q *CaseQuery) Priority(priorities ...int) *CaseQuery {
+	return q.Where("priority", intValues(priorities)...)
+}
+
+func (q *CaseQuery) CaseIds(ids ...int) *CaseQuery {
+	return q.Where("case_id", intValues(ids)...)
+}
+
+func (q *CaseQuery) AssignedGroup(groups ...string) *CaseQuery {
+	return q.Where("assigned_group", stringValues(groups)...)
+}
+
+func (q *CaseQuery) AssignedUser(users ...string) *CaseQuery {
+	return q.Where("assigned_user", stringValues(users)...)
+}
+
+func (q *CaseQuery) Subject(subject string) *CaseQuery {
+	return q.Where("subject", subject)
+}
+
+func (q *CaseQuery) Email(emails ...string) *CaseQuery {
+	return q.Where("email", stringValues(emails)...)
+}
+
+func (q *CaseQuery) Phone(phones ...string) *CaseQuery {
+	return q.Where("phone", stringValues(phones)...)
+}
+
+func (q *CaseQuery) Twitter(handles ...string) *CaseQuery {
+	return q.Where("twitter", stringValues(handles)...)
+}
+
+// Custom searches a custom field by its key, without the custom_ prefix.
+func (q *CaseQuery) Custom(name string, values ...interface{}) *CaseQuery {
+	return q.Where(customFieldPrefix+name, values...)
+}
+
+// CreatedBetween limits the search to cases created in a time range. A zero
+// time leaves that end of the range open.
+func (q *CaseQuery) CreatedBetween(from time.Time, to time.Time) *CaseQuery {
+	q.between("since_created_at", "max_created_at", from, to)
+	return q
+}
+
+// UpdatedBetween limits the search to cases updated in a time range. A zero
+// time leaves that end of the range open.
+func (q *CaseQuery) UpdatedBetween(from time.Time, to time.Time) *CaseQuery {
+	q.between("since_updated_at", "max_updated_at", from, to)
+	return q
+}
+
+// Fields limits the attributes returned for each case.
+func (q *CaseQuery) Fields(fields ...CaseField) *CaseQuery {
+	q.selectFields(fieldList(fields))
+	return q
+}
+
+// CustomerQuery builds the parameters of a customer search.
+// See Desk API: http://dev.desk.com/API/customers/#search
+type CustomerQuery struct {
+	searchQuery
+}
+
+func NewCustomerQuery() *CustomerQuery {
+	return &CustomerQuery{newSearchQuery(customerSearchFields)}
+}
+
+// Where adds a search field by its Desk parameter name, e.g. "email" or
+// "custom_level".
+func (q *CustomerQuery) Where(field string, values ...interface{}) *CustomerQuery {
+	q.where(field, values)
+	return q
+}
+
+// Text searches customers for free text.
+func (q *CustomerQuery) Text(text string) *CustomerQuery {
+	return q.Where("q", text)
+}
+
+func (q *CustomerQuery) FirstName(name string) *CustomerQuery {
+	return q.Where("first_name", name)
+}
+
+func (q *CustomerQuery) LastName(name string) *CustomerQuery {
+	return q.Where("last_name", name)
+}
+
+func (q *CustomerQuery) FullName(name string) *CustomerQuery {
+	return q.Where("full_name", name)
+}
+
+func (q *CustomerQuery) Email(emails ...string) *CustomerQuery {
+	return q.Where("email", stringValues(emails)...)
+}
+
+func (q *CustomerQuery) Phone(phones ...string) *CustomerQuery {
+	return q.Where("phone", stringValues(phones)...)
+}
+
+func (q *CustomerQuery) Twitter(handles ...string) *CustomerQuery {
+	return q.Where("twitter", stringValues(handles)...)
+}
+
+func (q *CustomerQuery) ExternalId(ids ...string) *CustomerQuery {
+	return q.Where("external_id", stringValues(ids)...)
+}
+
+// Custom searches a custom field by its key, without the custom_ prefix.
+func (q *CustomerQuery) Custom(name string, values ...interface{}) *CustomerQuery {
+	return q.Where(customFieldPrefix+name, values...)
+}
+
+// CreatedBetween limits the search to customers created in a time range. A
+// zero time leaves that end of the range open.
+func (q *CustomerQuery) CreatedBetween(from time.Time, to time.Time) *CustomerQuery {
+	q.between("since_created_at", "max_created_at", from, to)
+	return q
+}
+
+// UpdatedBetween limits the search to customers updated in a time range. A
+// zero time leaves that end of the range open.
+func (q *CustomerQuery) UpdatedBetween(from time.Time, to time.Time) *CustomerQuery {
+	q.between("since_updated_at", "max_updated_at", from, to)
+	return q
+}
+
+// Fields limits the attributes returned for each customer.
+func (q *CustomerQuery) Fields(fields ...CustomerField) *CustomerQuery {
+	q.selectFields(fieldList(fields))
+	return q
+}
+
+// CompanyQuery builds the parameters of a company search.
+// See Desk API: http://dev.desk.com/API/companies/#search
+type CompanyQuery struct {
+	searchQuery
+}
+
+func NewCompanyQuery() *CompanyQuery {
+	return &CompanyQuery{newSearchQuery(companySearchFields)}
+}
+
+// Where adds a search field by its Desk parameter name, e.g. "q" or
+// "custom_tier".
+func (q *CompanyQuery) Where(field string, values ...interface{}) *CompanyQuery {
+	q.where(field, values)
+	return q
+}
+
+// Text searches companies by name and domain.
+func (q *CompanyQuery) Text(text string) *CompanyQuery {
+	return q.Where("q", text)
+}
+
+// Custom searches a custom field by its key, without the custom_ prefix.
+func (q *CompanyQuery) Custom(name string, values ...interface{}) *CompanyQuery {
+	return q.Where(customFieldPrefix+name, values...)
+}
+
+// Fields limits the attributes returned for each company.
+func (q *CompanyQuery) Fields(fields ...CompanyField) *CompanyQuery {
+	q.selectFields(fieldList(fields))
+	return q
+}
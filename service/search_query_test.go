@@ -0,0 +1,99 @@
+package service
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	. "github.com/wtlangford/go-desk/types"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestSearchQuery(t *testing.T) {
+	fmt.Println("")
+	Convey("CaseQuery", t, func() {
+		Convey("should join multiple values with commas", func() {
+			params, err := NewCaseQuery().
+				Status("new", "open").
+				Labels("vip").
+				AssignedGroup("Support").
+				Params()
+			So(err, ShouldBeNil)
+			So(params.Get("status"), ShouldEqual, "new,open")
+			So(params.Get("labels"), ShouldEqual, "vip")
+			So(params.Get("assigned_group"), ShouldEqual, "Support")
+		})
+		Convey("should encode time ranges as unix timestamps", func() {
+			from := time.Date(2015, 3, 1, 0, 0, 0, 0, time.UTC)
+			to := time.Date(2015, 4, 1, 0, 0, 0, 0, time.UTC)
+			params, err := NewCaseQuery().CreatedBetween(from, to).UpdatedBetween(from, time.Time{}).Params()
+			So(err, ShouldBeNil)
+			So(params.Get("since_created_at"), ShouldEqual, "1425168000")
+			So(params.Get("max_created_at"), ShouldEqual, "1427846400")
+			So(params.Get("since_updated_at"), ShouldEqual, "1425168000")
+			So(params.Get("max_updated_at"), ShouldBeBlank)
+		})
+		Convey("should accept timestamps in where clauses", func() {
+			since := &Timestamp{Time: time.Date(2015, 3, 1, 0, 0, 0, 0, time.UTC)}
+			params, err := NewCaseQuery().Where("since_updated_at", since).Params()
+			So(err, ShouldBeNil)
+			So(params.Get("since_updated_at"), ShouldEqual, "1425168000")
+		})
+		Convey("should accept custom fields", func() {
+			params, err := NewCaseQuery().Custom("level", "gold").Params()
+			So(err, ShouldBeNil)
+			So(params.Get("custom_level"), ShouldEqual, "gold")
+		})
+		Convey("should reject unknown fields", func() {
+			_, err := NewCaseQuery().Where("stauts", "open").Status("new").Params()
+			So(err, ShouldHaveSameTypeAs, &SearchFieldError{})
+			So(err.(*SearchFieldError).Field, ShouldEqual, "stauts")
+		})
+		Convey("should reject values of the wrong type", func() {
+			query := NewCaseQuery().Where("priority", "high")
+			So(query.Err(), ShouldNotBeNil)
+			So(query.Err().Error(), ShouldContainSubstring, "expected an integer")
+			So(NewCaseQuery().Where("since_created_at", 12).Err(), ShouldNotBeNil)
+		})
+	})
+	Convey("CustomerQuery", t, func() {
+		Convey("should only accept customer fields", func() {
+			params, err := NewCustomerQuery().Email("jane@example.com").Twitter("jane").Params()
+			So(err, ShouldBeNil)
+			So(params.Get("email"), ShouldEqual, "jane@example.com")
+			So(params.Get("twitter"), ShouldEqual, "jane")
+			So(NewCustomerQuery().Where("status", "open").Err(), ShouldNotBeNil)
+		})
+	})
+	Convey("CompanyQuery", t, func() {
+		Convey("should search by text", func() {
+			params, err := NewCompanyQuery().Text("acme").Params()
+			So(err, ShouldBeNil)
+			So(params.Get("q"), ShouldEqual, "acme")
+		})
+	})
+	Convey("SearchWith", t, func() {
+		Convey("should send the query parameters", func() {
+			client, server, requests := newTestClient(200, `{"total_entries":0,"_embedded":{"entries":[]}}`)
+			defer server.Close()
+			_, _, err := client.Case.SearchWith(NewCaseQuery().Status("open"))
+			So(err, ShouldBeNil)
+			So((*requests)[0].Path, ShouldEqual, "/api/v2/cases/search")
+			So((*requests)[0].RawQuery, ShouldEqual, "status=open")
+		})
+		Convey("should not send an invalid query", func() {
+			client, server, requests := newTestClient(200, `{}`)
+			defer server.Close()
+			_, _, err := client.Customer.SearchWith(NewCustomerQuery().Where("nope", "x"))
+			So(err, ShouldNotBeNil)
+			So(len(*requests), ShouldEqual, 0)
+		})
+	})
+	Convey("SetSearchTime", t, func() {
+		Convey("should set raw params as unix timestamps", func() {
+			params := url.Values{}
+			SetSearchTime(&params, "since_created_at", time.Date(2015, 3, 1, 0, 0, 0, 0, time.UTC))
+			So(params.Encode(), ShouldEqual, "since_created_at=1425168000")
+		})
+	})
+}
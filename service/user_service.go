@@ -1,10 +1,8 @@
 package service
 
 import (
-	"encoding/json"
 	. "github.com/wtlangford/go-desk/resource"
 	"net/http"
-	"net/url"
 )
 
 type UserService struct {
@@ -12,14 +10,16 @@ type UserService struct {
 }
 
 // Get retrieves a user.
+// Only the given fields are returned when any are selected.
 // See Desk API: http://dev.desk.com/API/users/#show
-func (c *UserService) Get(id string) (*User, *http.Response, error) {
+func (c *UserService) Get(id string, fields ...UserField) (*User, *http.Response, error) {
 	restful := Restful{}
 	user := NewUser()
 	path := NewIdentityResourcePath(id, user)
 	resp, err := restful.
 		Get(path.Path()).
 		Json(user).
+		Params(fieldParams(fieldList(fields))).
 		Client(c.client).
 		Do()
 	return user, resp, err
@@ -27,7 +27,11 @@ func (c *UserService) Get(id string) (*User, *http.Response, error) {
 
 // List users with filtering and pagination.
 // See Desk API: http://dev.desk.com/API/users/#list
-func (c *UserService) List(params *url.Values) (*Page, *http.Response, error) {
+func (c *UserService) List(opts *ListOptions) (*Page, *http.Response, error) {
+	params, err := opts.paramsFor("user")
+	if err != nil {
+		return nil, nil, err
+	}
 	restful := Restful{}
 	page := new(Page)
 	path := NewResourcePath(NewUser())
@@ -44,35 +48,39 @@ func (c *UserService) List(params *url.Values) (*Page, *http.Response, error) {
 	if err != nil {
 		return nil, nil, err
 	}
+	page.SetNavigator(newPageNavigator(c.client, c.unravelPage))
 	return page, resp, err
 }
 
-// Update a user.
+// ListStream lists all users, fetching upcoming pages concurrently. The Page
+// of opts is ignored. Close the stream when done with it.
+func (c *UserService) ListStream(opts *ListOptions, prefetch *PrefetchOptions) *PageStream {
+	return listStream(c.client, c.List, opts, prefetch)
+}
+
+// Update a user. For a user fetched from Desk only the fields changed since
+// are sent, see Resource.Diff; a user built locally is sent whole.
 // See Desk API: http://dev.desk.com/API/users/#update
 func (c *UserService) Update(user *User) (*User, *http.Response, error) {
 	restful := Restful{}
+	body, err := changedFields(user)
+	if err != nil {
+		return nil, nil, err
+	}
 	updatedUser := new(User)
 	path := NewIdentityResourcePath(user.GetResourceId(), user)
 	resp, err := restful.
 		Patch(path.Path()).
-		Body(user).
+		Body(body).
 		Json(updatedUser).
 		Client(c.client).
 		Do()
+	if err == nil {
+		updated(user)
+	}
 	return updatedUser, resp, err
 }
 
 func (c *UserService) unravelPage(page *Page) error {
-	users := new([]User)
-	err := json.Unmarshal(*page.Embedded.RawEntries, &users)
-	if err != nil {
-		return err
-	}
-	page.Embedded.Entries = make([]interface{}, len(*users))
-	for i, v := range *users {
-		v.InitializeResource(v)
-		page.Embedded.Entries[i] = interface{}(v)
-	}
-	page.Embedded.RawEntries = nil
-	return err
+	return page.Embedded.DecodeEntries("user")
 }
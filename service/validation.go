@@ -0,0 +1,37 @@
+package service
+
+import (
+	. "github.com/wtlangford/go-desk/resource"
+)
+
+// validate runs the client side validation of model, if any, for op. The
+// fields in skip are not reported, e.g. a link implied by the request path.
+func validate(model interface{}, op Operation, skip ...string) error {
+	validator, ok := model.(Validator)
+	if !ok {
+		return nil
+	}
+	err := validator.Validate(op)
+	errs, ok := err.(ValidationErrors)
+	if !ok {
+		return err
+	}
+	for _, field := range skip {
+		delete(errs, field)
+	}
+	return errs.Err()
+}
+
+// AsValidationErrors returns the field errors of err, whether it was
+// returned by client side validation or by Desk in a 422 response.
+func AsValidationErrors(err error) (ValidationErrors, bool) {
+	switch e := err.(type) {
+	case ValidationErrors:
+		return e, true
+	case *ErrorResponse:
+		if e.Fields != nil {
+			return e.Fields, true
+		}
+	}
+	return nil, false
+}
@@ -21,7 +21,9 @@ var timestampType = reflect.TypeOf(Timestamp{})
 
 // Stringify attempts to create a reasonable string representation of types in
 // the GitHub library.  It does things like resolve pointers to their values
-// and omits struct fields with nil values.
+// and omits struct fields with nil values. Unexported struct fields are
+// omitted too, such as the snapshot and extras of resources: their values
+// cannot be read through reflection, so only their names would be printed.
 func Stringify(message interface{}) string {
 	var buf bytes.Buffer
 	v := reflect.ValueOf(message)
@@ -70,6 +72,9 @@ func stringifyValue(w io.Writer, val reflect.Value) {
 		var sep bool
 		for i := 0; i < v.NumField(); i++ {
 			fv := v.Field(i)
+			if v.Type().Field(i).PkgPath != "" {
+				continue
+			}
 			if fv.Kind() == reflect.Ptr && fv.IsNil() {
 				continue
 			}
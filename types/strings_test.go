@@ -0,0 +1,29 @@
+package types
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+type stringifySample struct {
+	Name    *string
+	Tags    []string
+	Count   int
+	hidden  map[string]interface{}
+	private string
+}
+
+func TestStringify(t *testing.T) {
+	fmt.Println("")
+	Convey("Stringify", t, func() {
+		Convey("should resolve pointers and omit nil fields", func() {
+			s := stringifySample{Name: String("Ada"), Count: 2}
+			So(Stringify(s), ShouldEqual, `types.stringifySample{Name:"Ada", Count:2}`)
+		})
+		Convey("should omit unexported fields", func() {
+			s := stringifySample{Tags: []string{"vip"}, hidden: map[string]interface{}{"a": 1}, private: "secret"}
+			So(Stringify(&s), ShouldEqual, `types.stringifySample{Tags:["vip"], Count:0}`)
+		})
+	})
+}
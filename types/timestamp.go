@@ -7,6 +7,7 @@ package types
 
 import (
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -22,17 +23,72 @@ func (t Timestamp) String() string {
 	return t.Time.String()
 }
 
+// timestampLayouts are the layouts of the string timestamps Desk sends.
+// Fractional seconds are accepted by each.
+var timestampLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05Z0700",
+}
+
 // UnmarshalJSON implements the json.Unmarshaler interface.
-// Time is expected in RFC3339 or Unix format.
+// Time is expected in RFC3339 or Unix format, optionally with fractional
+// seconds and quoted or not. A JSON null leaves t unchanged.
 func (t *Timestamp) UnmarshalJSON(data []byte) (err error) {
 	str := string(data)
-	i, err := strconv.ParseInt(str, 10, 64)
-	if err == nil {
-		(*t).Time = time.Unix(i, 0)
-	} else {
-		(*t).Time, err = time.Parse(`"`+time.RFC3339+`"`, str)
+	if str == "null" {
+		return nil
+	}
+	if len(str) < 2 || str[0] != '"' {
+		return t.parseUnix(str)
+	}
+	str = str[1 : len(str)-1]
+	for _, layout := range timestampLayouts {
+		var parsed time.Time
+		parsed, err = time.Parse(layout, str)
+		if err == nil {
+			(*t).Time = parsed
+			return nil
+		}
+	}
+	if t.parseUnix(str) == nil {
+		return nil
+	}
+	return err
+}
+
+func (t *Timestamp) parseUnix(str string) error {
+	parts := strings.SplitN(str, ".", 2)
+	sec, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return err
+	}
+	var nsec int64
+	if len(parts) == 2 {
+		// the fraction is read as nanoseconds so it encodes back unchanged
+		digits := (parts[1] + "000000000")[:9]
+		nsec, err = strconv.ParseInt(digits, 10, 64)
+		if err != nil {
+			return err
+		}
 	}
-	return
+	(*t).Time = time.Unix(sec, nsec).UTC()
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface. Time is encoded in
+// RFC3339 in UTC, with fractional seconds when it has any. The zero time is
+// encoded as null.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + t.UTC().Format(time.RFC3339Nano) + `"`), nil
+}
+
+// UnixString returns t as the decimal Unix seconds that search parameters
+// such as since_created_at expect.
+func (t Timestamp) UnixString() string {
+	return strconv.FormatInt(t.Unix(), 10)
 }
 
 // Equal reports whether t and u are equal based on time.Equal
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+	"time"
+)
+
+func TestTimestamp(t *testing.T) {
+	fmt.Println("")
+	expected := time.Date(2015, 1, 2, 3, 4, 5, 0, time.UTC)
+	decode := func(data string) (Timestamp, error) {
+		var ts Timestamp
+		err := json.Unmarshal([]byte(data), &ts)
+		return ts, err
+	}
+	Convey("UnmarshalJSON", t, func() {
+		Convey("should decode RFC3339", func() {
+			ts, err := decode(`"2015-01-02T03:04:05Z"`)
+			So(err, ShouldBeNil)
+			So(ts.Equal(Timestamp{Time: expected}), ShouldBeTrue)
+			ts, err = decode(`"2015-01-01T22:04:05-05:00"`)
+			So(err, ShouldBeNil)
+			So(ts.Equal(Timestamp{Time: expected}), ShouldBeTrue)
+		})
+		Convey("should decode fractional seconds", func() {
+			ts, err := decode(`"2015-01-02T03:04:05.250Z"`)
+			So(err, ShouldBeNil)
+			So(ts.Equal(Timestamp{Time: expected.Add(250 * time.Millisecond)}), ShouldBeTrue)
+			ts, err = decode(`"2015-01-02T03:04:05.250+0000"`)
+			So(err, ShouldBeNil)
+			So(ts.Equal(Timestamp{Time: expected.Add(250 * time.Millisecond)}), ShouldBeTrue)
+		})
+		Convey("should decode Unix seconds", func() {
+			ts, err := decode(`1420167845`)
+			So(err, ShouldBeNil)
+			So(ts.Equal(Timestamp{Time: expected}), ShouldBeTrue)
+			ts, err = decode(`1420167845.5`)
+			So(err, ShouldBeNil)
+			So(ts.Equal(Timestamp{Time: expected.Add(500 * time.Millisecond)}), ShouldBeTrue)
+			ts, err = decode(`"1420167845"`)
+			So(err, ShouldBeNil)
+			So(ts.Equal(Timestamp{Time: expected}), ShouldBeTrue)
+		})
+		Convey("should decode null", func() {
+			var value struct {
+				At *Timestamp `json:"at"`
+			}
+			err := json.Unmarshal([]byte(`{"at":null}`), &value)
+			So(err, ShouldBeNil)
+			So(value.At, ShouldBeNil)
+			ts, err := decode(`null`)
+			So(err, ShouldBeNil)
+			So(ts.IsZero(), ShouldBeTrue)
+		})
+		Convey("should reject other values", func() {
+			_, err := decode(`"yesterday"`)
+			So(err, ShouldNotBeNil)
+		})
+	})
+	Convey("MarshalJSON", t, func() {
+		Convey("should encode RFC3339 in UTC", func() {
+			local := expected.In(time.FixedZone("EST", -5*60*60))
+			data, err := json.Marshal(Timestamp{Time: local})
+			So(err, ShouldBeNil)
+			So(string(data), ShouldEqual, `"2015-01-02T03:04:05Z"`)
+		})
+		Convey("should keep fractional seconds", func() {
+			data, err := json.Marshal(Timestamp{Time: expected.Add(250 * time.Millisecond)})
+			So(err, ShouldBeNil)
+			So(string(data), ShouldEqual, `"2015-01-02T03:04:05.25Z"`)
+		})
+		Convey("should encode the zero time as null", func() {
+			data, _ := json.Marshal(Timestamp{})
+			So(string(data), ShouldEqual, `null`)
+		})
+		Convey("should round trip", func() {
+			data, _ := json.Marshal(Timestamp{Time: expected})
+			ts, err := decode(string(data))
+			So(err, ShouldBeNil)
+			So(ts.Equal(Timestamp{Time: expected}), ShouldBeTrue)
+		})
+		Convey("should round trip fractional seconds", func() {
+			for _, value := range []string{`"2015-01-02T03:04:05.250Z"`, `1420167845.123456789`} {
+				ts, err := decode(value)
+				So(err, ShouldBeNil)
+				data, err := json.Marshal(ts)
+				So(err, ShouldBeNil)
+				again, err := decode(string(data))
+				So(err, ShouldBeNil)
+				So(again.Equal(ts), ShouldBeTrue)
+				So(ts.Nanosecond(), ShouldBeGreaterThan, 0)
+			}
+			ts, _ := decode(`1420167845.123456789`)
+			data, _ := json.Marshal(ts)
+			So(string(data), ShouldEqual, `"2015-01-02T03:04:05.123456789Z"`)
+		})
+	})
+	Convey("UnixString", t, func() {
+		So(Timestamp{Time: expected}.UnixString(), ShouldEqual, "1420167845")
+	})
+}